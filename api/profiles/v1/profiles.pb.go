@@ -0,0 +1,750 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: profiles/v1/profiles.proto
+
+package profilesv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Profile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Official      bool                   `protobuf:"varint,3,opt,name=official,proto3" json:"official,omitempty"`
+	Pseudonym     *string                `protobuf:"bytes,4,opt,name=pseudonym,proto3,oneof" json:"pseudonym,omitempty"`
+	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Avatar        *string                `protobuf:"bytes,6,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
+	Sex           *string                `protobuf:"bytes,7,opt,name=sex,proto3,oneof" json:"sex,omitempty"`
+	BirthDate     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	Version       int64                  `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_profiles_v1_profiles_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_profiles_v1_profiles_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_profiles_v1_profiles_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Profile) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Profile) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Profile) GetOfficial() bool {
+	if x != nil {
+		return x.Official
+	}
+	return false
+}
+
+func (x *Profile) GetPseudonym() string {
+	if x != nil && x.Pseudonym != nil {
+		return *x.Pseudonym
+	}
+	return ""
+}
+
+func (x *Profile) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Profile) GetAvatar() string {
+	if x != nil && x.Avatar != nil {
+		return *x.Avatar
+	}
+	return ""
+}
+
+func (x *Profile) GetSex() string {
+	if x != nil && x.Sex != nil {
+		return *x.Sex
+	}
+	return ""
+}
+
+func (x *Profile) GetBirthDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BirthDate
+	}
+	return nil
+}
+
+func (x *Profile) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Profile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Profile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_profiles_v1_profiles_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profiles_v1_profiles_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_profiles_v1_profiles_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetProfileRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type GetProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileResponse) Reset() {
+	*x = GetProfileResponse{}
+	mi := &file_profiles_v1_profiles_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileResponse) ProtoMessage() {}
+
+func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profiles_v1_profiles_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileResponse) Descriptor() ([]byte, []int) {
+	return file_profiles_v1_profiles_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type GetProfileByUsernameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileByUsernameRequest) Reset() {
+	*x = GetProfileByUsernameRequest{}
+	mi := &file_profiles_v1_profiles_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileByUsernameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileByUsernameRequest) ProtoMessage() {}
+
+func (x *GetProfileByUsernameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profiles_v1_profiles_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileByUsernameRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileByUsernameRequest) Descriptor() ([]byte, []int) {
+	return file_profiles_v1_profiles_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetProfileByUsernameRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetProfileByUsernameResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Profile        *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	RedirectedFrom *string                `protobuf:"bytes,2,opt,name=redirected_from,json=redirectedFrom,proto3,oneof" json:"redirected_from,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetProfileByUsernameResponse) Reset() {
+	*x = GetProfileByUsernameResponse{}
+	mi := &file_profiles_v1_profiles_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileByUsernameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileByUsernameResponse) ProtoMessage() {}
+
+func (x *GetProfileByUsernameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profiles_v1_profiles_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileByUsernameResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileByUsernameResponse) Descriptor() ([]byte, []int) {
+	return file_profiles_v1_profiles_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetProfileByUsernameResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *GetProfileByUsernameResponse) GetRedirectedFrom() string {
+	if x != nil && x.RedirectedFrom != nil {
+		return *x.RedirectedFrom
+	}
+	return ""
+}
+
+type BatchGetProfilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountIds    []string               `protobuf:"bytes,1,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+	Usernames     []string               `protobuf:"bytes,2,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProfilesRequest) Reset() {
+	*x = BatchGetProfilesRequest{}
+	mi := &file_profiles_v1_profiles_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProfilesRequest) ProtoMessage() {}
+
+func (x *BatchGetProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profiles_v1_profiles_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProfilesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_profiles_v1_profiles_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchGetProfilesRequest) GetAccountIds() []string {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *BatchGetProfilesRequest) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
+	}
+	return nil
+}
+
+type BatchGetProfilesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Profiles          []*Profile             `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	MissingAccountIds []string               `protobuf:"bytes,2,rep,name=missing_account_ids,json=missingAccountIds,proto3" json:"missing_account_ids,omitempty"`
+	MissingUsernames  []string               `protobuf:"bytes,3,rep,name=missing_usernames,json=missingUsernames,proto3" json:"missing_usernames,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BatchGetProfilesResponse) Reset() {
+	*x = BatchGetProfilesResponse{}
+	mi := &file_profiles_v1_profiles_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProfilesResponse) ProtoMessage() {}
+
+func (x *BatchGetProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profiles_v1_profiles_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProfilesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_profiles_v1_profiles_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchGetProfilesResponse) GetProfiles() []*Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *BatchGetProfilesResponse) GetMissingAccountIds() []string {
+	if x != nil {
+		return x.MissingAccountIds
+	}
+	return nil
+}
+
+func (x *BatchGetProfilesResponse) GetMissingUsernames() []string {
+	if x != nil {
+		return x.MissingUsernames
+	}
+	return nil
+}
+
+type FilterProfilesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UsernamePrefix  *string                `protobuf:"bytes,1,opt,name=username_prefix,json=usernamePrefix,proto3,oneof" json:"username_prefix,omitempty"`
+	PseudonymPrefix *string                `protobuf:"bytes,2,opt,name=pseudonym_prefix,json=pseudonymPrefix,proto3,oneof" json:"pseudonym_prefix,omitempty"`
+	Official        *bool                  `protobuf:"varint,3,opt,name=official,proto3,oneof" json:"official,omitempty"`
+	CreatedAfter    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	UpdatedAfter    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	Sort            string                 `protobuf:"bytes,7,opt,name=sort,proto3" json:"sort,omitempty"`
+	Offset          int32                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit           int32                  `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FilterProfilesRequest) Reset() {
+	*x = FilterProfilesRequest{}
+	mi := &file_profiles_v1_profiles_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterProfilesRequest) ProtoMessage() {}
+
+func (x *FilterProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profiles_v1_profiles_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterProfilesRequest.ProtoReflect.Descriptor instead.
+func (*FilterProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_profiles_v1_profiles_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FilterProfilesRequest) GetUsernamePrefix() string {
+	if x != nil && x.UsernamePrefix != nil {
+		return *x.UsernamePrefix
+	}
+	return ""
+}
+
+func (x *FilterProfilesRequest) GetPseudonymPrefix() string {
+	if x != nil && x.PseudonymPrefix != nil {
+		return *x.PseudonymPrefix
+	}
+	return ""
+}
+
+func (x *FilterProfilesRequest) GetOfficial() bool {
+	if x != nil && x.Official != nil {
+		return *x.Official
+	}
+	return false
+}
+
+func (x *FilterProfilesRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *FilterProfilesRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *FilterProfilesRequest) GetUpdatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return nil
+}
+
+func (x *FilterProfilesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *FilterProfilesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *FilterProfilesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FilterProfilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profiles      []*Profile             `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	Total         uint64                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterProfilesResponse) Reset() {
+	*x = FilterProfilesResponse{}
+	mi := &file_profiles_v1_profiles_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterProfilesResponse) ProtoMessage() {}
+
+func (x *FilterProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profiles_v1_profiles_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterProfilesResponse.ProtoReflect.Descriptor instead.
+func (*FilterProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_profiles_v1_profiles_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FilterProfilesResponse) GetProfiles() []*Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *FilterProfilesResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_profiles_v1_profiles_proto protoreflect.FileDescriptor
+
+const file_profiles_v1_profiles_proto_rawDesc = "" +
+	"\n" +
+	"\x1aprofiles/v1/profiles.proto\x12\vprofiles.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xda\x03\n" +
+	"\aProfile\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bofficial\x18\x03 \x01(\bR\bofficial\x12!\n" +
+	"\tpseudonym\x18\x04 \x01(\tH\x00R\tpseudonym\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1b\n" +
+	"\x06avatar\x18\x06 \x01(\tH\x02R\x06avatar\x88\x01\x01\x12\x15\n" +
+	"\x03sex\x18\a \x01(\tH\x03R\x03sex\x88\x01\x01\x129\n" +
+	"\n" +
+	"birth_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tbirthDate\x12\x18\n" +
+	"\aversion\x18\t \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\f\n" +
+	"\n" +
+	"_pseudonymB\x0e\n" +
+	"\f_descriptionB\t\n" +
+	"\a_avatarB\x06\n" +
+	"\x04_sex\"2\n" +
+	"\x11GetProfileRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"D\n" +
+	"\x12GetProfileResponse\x12.\n" +
+	"\aprofile\x18\x01 \x01(\v2\x14.profiles.v1.ProfileR\aprofile\"9\n" +
+	"\x1bGetProfileByUsernameRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"\x90\x01\n" +
+	"\x1cGetProfileByUsernameResponse\x12.\n" +
+	"\aprofile\x18\x01 \x01(\v2\x14.profiles.v1.ProfileR\aprofile\x12,\n" +
+	"\x0fredirected_from\x18\x02 \x01(\tH\x00R\x0eredirectedFrom\x88\x01\x01B\x12\n" +
+	"\x10_redirected_from\"X\n" +
+	"\x17BatchGetProfilesRequest\x12\x1f\n" +
+	"\vaccount_ids\x18\x01 \x03(\tR\n" +
+	"accountIds\x12\x1c\n" +
+	"\tusernames\x18\x02 \x03(\tR\tusernames\"\xa9\x01\n" +
+	"\x18BatchGetProfilesResponse\x120\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x14.profiles.v1.ProfileR\bprofiles\x12.\n" +
+	"\x13missing_account_ids\x18\x02 \x03(\tR\x11missingAccountIds\x12+\n" +
+	"\x11missing_usernames\x18\x03 \x03(\tR\x10missingUsernames\"\xd3\x03\n" +
+	"\x15FilterProfilesRequest\x12,\n" +
+	"\x0fusername_prefix\x18\x01 \x01(\tH\x00R\x0eusernamePrefix\x88\x01\x01\x12.\n" +
+	"\x10pseudonym_prefix\x18\x02 \x01(\tH\x01R\x0fpseudonymPrefix\x88\x01\x01\x12\x1f\n" +
+	"\bofficial\x18\x03 \x01(\bH\x02R\bofficial\x88\x01\x01\x12?\n" +
+	"\rcreated_after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12?\n" +
+	"\rupdated_after\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fupdatedAfter\x12\x12\n" +
+	"\x04sort\x18\a \x01(\tR\x04sort\x12\x16\n" +
+	"\x06offset\x18\b \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\t \x01(\x05R\x05limitB\x12\n" +
+	"\x10_username_prefixB\x13\n" +
+	"\x11_pseudonym_prefixB\v\n" +
+	"\t_official\"`\n" +
+	"\x16FilterProfilesResponse\x120\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x14.profiles.v1.ProfileR\bprofiles\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total2\x89\x03\n" +
+	"\x0fProfilesService\x12M\n" +
+	"\n" +
+	"GetProfile\x12\x1e.profiles.v1.GetProfileRequest\x1a\x1f.profiles.v1.GetProfileResponse\x12k\n" +
+	"\x14GetProfileByUsername\x12(.profiles.v1.GetProfileByUsernameRequest\x1a).profiles.v1.GetProfileByUsernameResponse\x12_\n" +
+	"\x10BatchGetProfiles\x12$.profiles.v1.BatchGetProfilesRequest\x1a%.profiles.v1.BatchGetProfilesResponse\x12Y\n" +
+	"\x0eFilterProfiles\x12\".profiles.v1.FilterProfilesRequest\x1a#.profiles.v1.FilterProfilesResponseB;Z9github.com/umisto/profiles-svc/api/profiles/v1;profilesv1b\x06proto3"
+
+var (
+	file_profiles_v1_profiles_proto_rawDescOnce sync.Once
+	file_profiles_v1_profiles_proto_rawDescData []byte
+)
+
+func file_profiles_v1_profiles_proto_rawDescGZIP() []byte {
+	file_profiles_v1_profiles_proto_rawDescOnce.Do(func() {
+		file_profiles_v1_profiles_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_profiles_v1_profiles_proto_rawDesc), len(file_profiles_v1_profiles_proto_rawDesc)))
+	})
+	return file_profiles_v1_profiles_proto_rawDescData
+}
+
+var file_profiles_v1_profiles_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_profiles_v1_profiles_proto_goTypes = []any{
+	(*Profile)(nil),                      // 0: profiles.v1.Profile
+	(*GetProfileRequest)(nil),            // 1: profiles.v1.GetProfileRequest
+	(*GetProfileResponse)(nil),           // 2: profiles.v1.GetProfileResponse
+	(*GetProfileByUsernameRequest)(nil),  // 3: profiles.v1.GetProfileByUsernameRequest
+	(*GetProfileByUsernameResponse)(nil), // 4: profiles.v1.GetProfileByUsernameResponse
+	(*BatchGetProfilesRequest)(nil),      // 5: profiles.v1.BatchGetProfilesRequest
+	(*BatchGetProfilesResponse)(nil),     // 6: profiles.v1.BatchGetProfilesResponse
+	(*FilterProfilesRequest)(nil),        // 7: profiles.v1.FilterProfilesRequest
+	(*FilterProfilesResponse)(nil),       // 8: profiles.v1.FilterProfilesResponse
+	(*timestamppb.Timestamp)(nil),        // 9: google.protobuf.Timestamp
+}
+var file_profiles_v1_profiles_proto_depIdxs = []int32{
+	9,  // 0: profiles.v1.Profile.birth_date:type_name -> google.protobuf.Timestamp
+	9,  // 1: profiles.v1.Profile.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 2: profiles.v1.Profile.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: profiles.v1.GetProfileResponse.profile:type_name -> profiles.v1.Profile
+	0,  // 4: profiles.v1.GetProfileByUsernameResponse.profile:type_name -> profiles.v1.Profile
+	0,  // 5: profiles.v1.BatchGetProfilesResponse.profiles:type_name -> profiles.v1.Profile
+	9,  // 6: profiles.v1.FilterProfilesRequest.created_after:type_name -> google.protobuf.Timestamp
+	9,  // 7: profiles.v1.FilterProfilesRequest.created_before:type_name -> google.protobuf.Timestamp
+	9,  // 8: profiles.v1.FilterProfilesRequest.updated_after:type_name -> google.protobuf.Timestamp
+	0,  // 9: profiles.v1.FilterProfilesResponse.profiles:type_name -> profiles.v1.Profile
+	1,  // 10: profiles.v1.ProfilesService.GetProfile:input_type -> profiles.v1.GetProfileRequest
+	3,  // 11: profiles.v1.ProfilesService.GetProfileByUsername:input_type -> profiles.v1.GetProfileByUsernameRequest
+	5,  // 12: profiles.v1.ProfilesService.BatchGetProfiles:input_type -> profiles.v1.BatchGetProfilesRequest
+	7,  // 13: profiles.v1.ProfilesService.FilterProfiles:input_type -> profiles.v1.FilterProfilesRequest
+	2,  // 14: profiles.v1.ProfilesService.GetProfile:output_type -> profiles.v1.GetProfileResponse
+	4,  // 15: profiles.v1.ProfilesService.GetProfileByUsername:output_type -> profiles.v1.GetProfileByUsernameResponse
+	6,  // 16: profiles.v1.ProfilesService.BatchGetProfiles:output_type -> profiles.v1.BatchGetProfilesResponse
+	8,  // 17: profiles.v1.ProfilesService.FilterProfiles:output_type -> profiles.v1.FilterProfilesResponse
+	14, // [14:18] is the sub-list for method output_type
+	10, // [10:14] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_profiles_v1_profiles_proto_init() }
+func file_profiles_v1_profiles_proto_init() {
+	if File_profiles_v1_profiles_proto != nil {
+		return
+	}
+	file_profiles_v1_profiles_proto_msgTypes[0].OneofWrappers = []any{}
+	file_profiles_v1_profiles_proto_msgTypes[4].OneofWrappers = []any{}
+	file_profiles_v1_profiles_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_profiles_v1_profiles_proto_rawDesc), len(file_profiles_v1_profiles_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_profiles_v1_profiles_proto_goTypes,
+		DependencyIndexes: file_profiles_v1_profiles_proto_depIdxs,
+		MessageInfos:      file_profiles_v1_profiles_proto_msgTypes,
+	}.Build()
+	File_profiles_v1_profiles_proto = out.File
+	file_profiles_v1_profiles_proto_goTypes = nil
+	file_profiles_v1_profiles_proto_depIdxs = nil
+}
@@ -4,15 +4,23 @@ import (
 	"context"
 	"database/sql"
 	"sync"
+	"time"
 
 	"github.com/umisto/kafkakit/box"
 	"github.com/umisto/logium"
+	"github.com/umisto/profiles-svc/docs"
 	"github.com/umisto/profiles-svc/internal"
+	"github.com/umisto/profiles-svc/internal/domain/modules/inbox"
 	"github.com/umisto/profiles-svc/internal/domain/modules/profile"
 	"github.com/umisto/profiles-svc/internal/events/consumer"
 	"github.com/umisto/profiles-svc/internal/events/consumer/callback"
+	"github.com/umisto/profiles-svc/internal/events/producer"
+	"github.com/umisto/profiles-svc/internal/health"
 	"github.com/umisto/profiles-svc/internal/repo"
 	"github.com/umisto/profiles-svc/internal/rest/middlewares"
+	"github.com/umisto/profiles-svc/internal/rpc"
+	"github.com/umisto/profiles-svc/internal/storage"
+	"github.com/umisto/profiles-svc/internal/tracing"
 
 	"github.com/umisto/profiles-svc/internal/rest"
 	"github.com/umisto/profiles-svc/internal/rest/controller"
@@ -27,6 +35,17 @@ func StartServices(ctx context.Context, cfg internal.Config, log logium.Logger,
 		}()
 	}
 
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Config{
+		ServiceName: cfg.Service.Name,
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.Endpoint,
+		Insecure:    cfg.Tracing.Insecure,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		log.Fatal("failed to set up tracing", "error", err)
+	}
+
 	pg, err := sql.Open("postgres", cfg.Database.SQL.URL)
 	if err != nil {
 		log.Fatal("failed to connect to database", "error", err)
@@ -35,17 +54,67 @@ func StartServices(ctx context.Context, cfg internal.Config, log logium.Logger,
 	database := repo.New(pg)
 	kafkaBox := box.New(pg)
 
-	profileSvc := profile.New(database)
+	kafkaProducer := producer.New(log, database)
 
-	ctrl := controller.New(log, profileSvc)
-	mdlv := middlewares.New(log)
+	files, err := storage.NewFS(cfg.Storage.Dir, cfg.Storage.BaseURL)
+	if err != nil {
+		log.Fatal("failed to set up file storage", "error", err)
+	}
 
-	kafkaConsumer := consumer.New(log, cfg.Kafka.Brokers, callback.NewService(log, kafkaBox))
-	kafkaInboxWorker := consumer.NewInboxWorker(log, kafkaBox, profileSvc)
+	profileSvc := profile.New(log, database, kafkaProducer, files, profile.Config{
+		BatchLimit:           cfg.Profiles.BatchLimit,
+		UsernameGracePeriod:  cfg.Profiles.UsernameGracePeriod,
+		AvatarSizes:          cfg.Avatars.Sizes,
+		AvatarMaxSize:        cfg.Avatars.MaxSize,
+		AllowExternalAvatars: cfg.Avatars.AllowExternalURLs,
+	})
+
+	inboxSvc := inbox.New(database, kafkaBox)
+
+	healthChecker := health.New(pg, cfg.Kafka.Brokers, database, health.Config{
+		Timeout:     cfg.Health.Timeout,
+		InboxMaxAge: cfg.Health.InboxMaxAge,
+	})
+
+	ctrl := controller.New(log, profileSvc, inboxSvc, healthChecker)
+	mdlv, err := middlewares.New(log, docs.Spec)
+	if err != nil {
+		log.Fatal("failed to set up request validation", "error", err)
+	}
+
+	kafkaConsumer := consumer.New(log, cfg.Kafka.Brokers, callback.NewService(log, kafkaBox, database))
+	kafkaInboxWorker := consumer.NewInboxWorker(log, kafkaBox, database, profileSvc, kafkaProducer, consumer.InboxWorkerConfig{
+		Workers:        cfg.Kafka.Inbox.Workers,
+		BatchSize:      cfg.Kafka.Inbox.BatchSize,
+		Lease:          cfg.Kafka.Inbox.Lease,
+		MaxAttempts:    cfg.Kafka.Inbox.MaxAttempts,
+		RetryBaseDelay: cfg.Kafka.Inbox.RetryBaseDelay,
+		RetryMaxDelay:  cfg.Kafka.Inbox.RetryMaxDelay,
+		DeadLetter:     cfg.Kafka.Inbox.DeadLetter,
+	})
+	kafkaOutboxWorker := producer.NewOutboxWorker(log, cfg.Kafka.Brokers, database)
 
 	run(func() { kafkaConsumer.Run(ctx) })
 
 	run(func() { kafkaInboxWorker.Run(ctx) })
 
-	run(func() { rest.Run(ctx, cfg, log, mdlv, ctrl) })
+	run(func() { kafkaOutboxWorker.Run(ctx) })
+
+	run(func() { rest.Run(ctx, cfg, log, mdlv, ctrl, files.Handler()) })
+
+	run(func() { rpc.Run(ctx, cfg, log, profileSvc) })
+
+	if cfg.Swagger.Enabled {
+		run(func() { rest.RunSwagger(ctx, cfg.Swagger, log) })
+	}
+
+	run(func() {
+		<-ctx.Done()
+
+		shCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(shCtx); err != nil {
+			log.Errorf("tracing shutdown error: %v", err)
+		}
+	})
 }
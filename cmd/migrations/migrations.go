@@ -40,3 +40,13 @@ func MigrateDown(url string) error {
 
 	return nil
 }
+
+// Pending returns the number of embedded migrations not yet applied to the database.
+func Pending(db *sql.DB) (int, error) {
+	planned, _, err := migrate.PlanMigration(db, "postgres", migrations, migrate.Up, 0)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to plan migrations")
+	}
+
+	return len(planned), nil
+}
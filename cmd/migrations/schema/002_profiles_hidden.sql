@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE profiles ADD COLUMN hidden BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +migrate Down
+ALTER TABLE profiles DROP COLUMN IF EXISTS hidden;
@@ -0,0 +1,15 @@
+-- +migrate Up
+CREATE TABLE profile_resets (
+    id             UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    account_id     UUID NOT NULL,
+    initiator_id   UUID NOT NULL,
+    reason         VARCHAR(255) NOT NULL,
+    username_reset BOOLEAN NOT NULL DEFAULT FALSE,
+
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX profile_resets_account_id_idx ON profile_resets (account_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS profile_resets CASCADE;
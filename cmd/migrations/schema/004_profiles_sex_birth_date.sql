@@ -0,0 +1,15 @@
+-- +migrate Up
+CREATE TYPE profile_sex AS ENUM (
+    'male',
+    'female',
+    'other'
+);
+
+ALTER TABLE profiles ADD COLUMN sex profile_sex;
+ALTER TABLE profiles ADD COLUMN birth_date DATE;
+
+-- +migrate Down
+ALTER TABLE profiles DROP COLUMN IF EXISTS birth_date;
+ALTER TABLE profiles DROP COLUMN IF EXISTS sex;
+
+DROP TYPE IF EXISTS profile_sex;
@@ -0,0 +1,5 @@
+-- +migrate Up
+CREATE INDEX IF NOT EXISTS profiles_created_at_account_id_idx ON profiles (created_at, account_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS profiles_created_at_account_id_idx;
@@ -0,0 +1,21 @@
+-- +migrate Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE profiles ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', coalesce(username, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(pseudonym, '')), 'B') ||
+    setweight(to_tsvector('simple', coalesce(description, '')), 'C')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS profiles_search_vector_idx ON profiles USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS profiles_username_trgm_idx ON profiles USING GIN (username gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS profiles_pseudonym_trgm_idx ON profiles USING GIN (pseudonym gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS profiles_description_trgm_idx ON profiles USING GIN (description gin_trgm_ops);
+
+-- +migrate Down
+DROP INDEX IF EXISTS profiles_description_trgm_idx;
+DROP INDEX IF EXISTS profiles_pseudonym_trgm_idx;
+DROP INDEX IF EXISTS profiles_username_trgm_idx;
+DROP INDEX IF EXISTS profiles_search_vector_idx;
+
+ALTER TABLE profiles DROP COLUMN IF EXISTS search_vector;
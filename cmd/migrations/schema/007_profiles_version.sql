@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE profiles ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+-- +migrate Down
+ALTER TABLE profiles DROP COLUMN IF EXISTS version;
@@ -0,0 +1,14 @@
+-- +migrate Up
+CREATE TABLE profile_username_history (
+    id         UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    account_id UUID NOT NULL REFERENCES profiles (account_id) ON DELETE CASCADE,
+    username   VARCHAR(32) NOT NULL,
+
+    retired_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX profile_username_history_username_idx ON profile_username_history (username, retired_at DESC);
+CREATE INDEX profile_username_history_account_id_idx ON profile_username_history (account_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS profile_username_history CASCADE;
@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE profiles ADD COLUMN username_updated_at TIMESTAMPTZ;
+
+-- +migrate Down
+ALTER TABLE profiles DROP COLUMN IF EXISTS username_updated_at;
@@ -0,0 +1,7 @@
+-- +migrate Up
+CREATE INDEX IF NOT EXISTS inbox_events_status_next_retry_at_idx ON inbox_events (status, next_retry_at);
+CREATE INDEX IF NOT EXISTS inbox_events_key_created_at_idx ON inbox_events (key, created_at);
+
+-- +migrate Down
+DROP INDEX IF EXISTS inbox_events_key_created_at_idx;
+DROP INDEX IF EXISTS inbox_events_status_next_retry_at_idx;
@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE inbox_events ADD COLUMN IF NOT EXISTS trace_context JSONB;
+
+-- +migrate Down
+ALTER TABLE inbox_events DROP COLUMN IF EXISTS trace_context;
@@ -0,0 +1,8 @@
+-- +migrate Up
+ALTER TABLE profiles ADD COLUMN banner TEXT;
+ALTER TABLE profiles ADD COLUMN accent_color VARCHAR(7)
+    CONSTRAINT profiles_accent_color_hex CHECK (accent_color ~ '^#[0-9a-f]{6}$');
+
+-- +migrate Down
+ALTER TABLE profiles DROP COLUMN IF EXISTS accent_color;
+ALTER TABLE profiles DROP COLUMN IF EXISTS banner;
@@ -0,0 +1,9 @@
+// Package docs embeds the bundled OpenAPI spec, so the service can serve it.
+package docs
+
+import _ "embed"
+
+// Spec is api-bundled.yaml, regenerate it after changing api.yaml or anything under spec.
+//
+//go:embed api-bundled.yaml
+var Spec []byte
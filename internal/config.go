@@ -34,8 +34,41 @@ type DatabaseConfig struct {
 	} `mapstructure:"sql"`
 }
 
+type ProfilesConfig struct {
+	BatchLimit          int           `mapstructure:"batch_limit"`
+	UsernameGracePeriod time.Duration `mapstructure:"username_grace_period"`
+}
+
+type AvatarsConfig struct {
+	Sizes   []int `mapstructure:"sizes"`
+	MaxSize int64 `mapstructure:"max_size"`
+	// AllowExternalURLs lets users set avatar URLs that were not issued by an upload.
+	AllowExternalURLs bool `mapstructure:"allow_external_urls"`
+}
+
+// StorageConfig points to the directory uploaded files are kept in and the URL they are served from.
+type StorageConfig struct {
+	Dir     string `mapstructure:"dir"`
+	BaseURL string `mapstructure:"base_url"`
+}
+
 type KafkaConfig struct {
 	Brokers []string `mapstructure:"brokers"`
+	Inbox   struct {
+		Workers        int           `mapstructure:"workers"`
+		BatchSize      int32         `mapstructure:"batch_size"`
+		Lease          time.Duration `mapstructure:"lease"`
+		MaxAttempts    int32         `mapstructure:"max_attempts"`
+		RetryBaseDelay time.Duration `mapstructure:"retry_base_delay"`
+		RetryMaxDelay  time.Duration `mapstructure:"retry_max_delay"`
+		DeadLetter     bool          `mapstructure:"dead_letter"`
+	} `mapstructure:"inbox"`
+}
+
+type GRPCConfig struct {
+	Port string `mapstructure:"port"`
+	// AllowedServices limits which token subjects may call, empty allows any service with a valid token.
+	AllowedServices []string `mapstructure:"allowed_services"`
 }
 
 type JWTConfig struct {
@@ -45,6 +78,10 @@ type JWTConfig struct {
 			TokenLifetime time.Duration `mapstructure:"token_lifetime"`
 		} `mapstructure:"access_token"`
 	} `mapstructure:"user"`
+	// Service tokens are what other services present to the gRPC API.
+	Service struct {
+		SecretKey string `mapstructure:"secret_key"`
+	} `mapstructure:"service"`
 }
 
 type SwaggerConfig struct {
@@ -53,14 +90,32 @@ type SwaggerConfig struct {
 	Port    string `mapstructure:"port"`
 }
 
+type HealthConfig struct {
+	Timeout     time.Duration `mapstructure:"timeout"`
+	InboxMaxAge time.Duration `mapstructure:"inbox_max_age"`
+}
+
+type TracingConfig struct {
+	Exporter    string  `mapstructure:"exporter"`
+	Endpoint    string  `mapstructure:"endpoint"`
+	Insecure    bool    `mapstructure:"insecure"`
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
 type Config struct {
 	Service  ServiceConfig  `mapstructure:"service"`
 	Log      LogConfig      `mapstructure:"log"`
 	Rest     RestConfig     `mapstructure:"rest"`
+	GRPC     GRPCConfig     `mapstructure:"grpc"`
 	JWT      JWTConfig      `mapstructure:"jwt"`
 	Kafka    KafkaConfig    `mapstructure:"kafka"`
 	Database DatabaseConfig `mapstructure:"database"`
 	Swagger  SwaggerConfig  `mapstructure:"swagger"`
+	Profiles ProfilesConfig `mapstructure:"profiles"`
+	Avatars  AvatarsConfig  `mapstructure:"avatars"`
+	Storage  StorageConfig  `mapstructure:"storage"`
+	Health   HealthConfig   `mapstructure:"health"`
+	Tracing  TracingConfig  `mapstructure:"tracing"`
 }
 
 func LoadConfig() (Config, error) {
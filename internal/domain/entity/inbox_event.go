@@ -0,0 +1,18 @@
+package entity
+
+import "github.com/umisto/kafkakit/box"
+
+type InboxEventCollection struct {
+	Data   []box.InboxEvent `json:"data"`
+	Offset uint             `json:"offset"`
+	Size   uint             `json:"size"`
+	Total  uint             `json:"total"`
+}
+
+// ClaimedInboxEvent is an inbox event taken for processing together with the trace context
+// of the message it was received from.
+type ClaimedInboxEvent struct {
+	box.InboxEvent
+
+	TraceContext map[string]string
+}
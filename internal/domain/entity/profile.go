@@ -7,24 +7,61 @@ import (
 )
 
 type Profile struct {
-	AccountID   uuid.UUID `json:"account_id"`
-	Username    string    `json:"username"`
-	Official    bool      `json:"official"`
-	Pseudonym   *string   `json:"pseudonym,omitempty"`
-	Description *string   `json:"description,omitempty"`
-	Avatar      *string   `json:"avatar,omitempty"`
+	AccountID         uuid.UUID  `json:"account_id"`
+	Username          string     `json:"username"`
+	UsernameUpdatedAt *time.Time `json:"username_updated_at,omitempty"`
+	Official          bool       `json:"official"`
+	Pseudonym         *string    `json:"pseudonym,omitempty"`
+	Description       *string    `json:"description,omitempty"`
+	Avatar            *string    `json:"avatar,omitempty"`
+	Banner            *string    `json:"banner,omitempty"`
+	AccentColor       *string    `json:"accent_color,omitempty"`
+	Sex               *string    `json:"sex,omitempty"`
+	BirthDate         *time.Time `json:"birth_date,omitempty"`
+	Hidden            bool       `json:"hidden"`
+	Version           int64      `json:"version"`
 
 	UpdatedAt time.Time `json:"updated_at"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
+const (
+	SexMale   = "male"
+	SexFemale = "female"
+	SexOther  = "other"
+)
+
+var Sexes = []string{SexMale, SexFemale, SexOther}
+
 func (e Profile) IsNil() bool {
 	return e.AccountID == uuid.Nil
 }
 
 type ProfileCollection struct {
-	Data  []Profile `json:"data"`
-	Page  uint      `json:"page"`
-	Size  uint      `json:"size"`
-	Total uint      `json:"total"`
+	Data   []Profile `json:"data"`
+	Offset uint      `json:"offset"`
+	Size   uint      `json:"size"`
+	Total  uint      `json:"total"`
+
+	NextCursor *ProfileCursor `json:"-"`
+	PrevCursor *ProfileCursor `json:"-"`
+}
+
+// ProfileBatch is the result of a batch lookup, identifiers that matched no visible profile are listed as missing.
+type ProfileBatch struct {
+	Data             []Profile   `json:"data"`
+	MissingIDs       []uuid.UUID `json:"missing_ids"`
+	MissingUsernames []string    `json:"missing_usernames"`
+}
+
+type ProfileSearchResult struct {
+	Profile Profile `json:"profile"`
+	Score   float64 `json:"score"`
+}
+
+type ProfileSearchCollection struct {
+	Data   []ProfileSearchResult `json:"data"`
+	Offset uint                  `json:"offset"`
+	Size   uint                  `json:"size"`
+	Total  uint                  `json:"total"`
 }
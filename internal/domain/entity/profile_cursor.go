@@ -0,0 +1,15 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProfileCursor points at a profile in the newest-first (created_at, account_id) listing order.
+// Backward cursors select the page placed before the profile, forward ones the page after it.
+type ProfileCursor struct {
+	CreatedAt time.Time
+	AccountID uuid.UUID
+	Backward  bool
+}
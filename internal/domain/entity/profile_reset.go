@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProfileReset is an audit record of a moderator wiping the public fields of a profile.
+type ProfileReset struct {
+	ID            uuid.UUID `json:"id"`
+	AccountID     uuid.UUID `json:"account_id"`
+	InitiatorID   uuid.UUID `json:"initiator_id"`
+	Reason        string    `json:"reason"`
+	UsernameReset bool      `json:"username_reset"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
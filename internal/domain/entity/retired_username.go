@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RetiredUsername is a username the account used before, kept to redirect old links to the profile.
+type RetiredUsername struct {
+	ID        uuid.UUID `json:"id"`
+	AccountID uuid.UUID `json:"account_id"`
+	Username  string    `json:"username"`
+	RetiredAt time.Time `json:"retired_at"`
+}
+
+func (e RetiredUsername) IsNil() bool {
+	return e.ID == uuid.Nil
+}
@@ -0,0 +1,9 @@
+package errx
+
+import (
+	"github.com/umisto/ape"
+)
+
+var ErrorInboxEventNotFound = ape.DeclareError("INBOX_EVENT_NOT_FOUND")
+
+var ErrorInboxEventStatusConflict = ape.DeclareError("INBOX_EVENT_STATUS_CONFLICT")
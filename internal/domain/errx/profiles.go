@@ -17,3 +17,25 @@ var ErrorSexIsNotValid = ape.DeclareError("SEX_IS_NOT_VALID")
 var ErrorBirthdateIsNotValid = ape.DeclareError("BIRTHDATE_IS_NOT_VALID")
 
 var ErrorUserTooYoung = ape.DeclareError("USER_TOO_YOUNG")
+
+var ErrorSearchQueryIsNotValid = ape.DeclareError("SEARCH_QUERY_IS_NOT_VALID")
+
+var ErrorSortIsNotValid = ape.DeclareError("SORT_IS_NOT_VALID")
+
+var ErrorBatchTooLarge = ape.DeclareError("BATCH_TOO_LARGE")
+
+var ErrorProfileVersionConflict = ape.DeclareError("PROFILE_VERSION_CONFLICT")
+
+var ErrorUsernameChangeOutdated = ape.DeclareError("USERNAME_CHANGE_OUTDATED")
+
+var ErrorImageIsNotValid = ape.DeclareError("IMAGE_IS_NOT_VALID")
+
+var ErrorImageTooLarge = ape.DeclareError("IMAGE_TOO_LARGE")
+
+var ErrorAvatarURLNotAllowed = ape.DeclareError("AVATAR_URL_NOT_ALLOWED")
+
+var ErrorBannerURLIsNotValid = ape.DeclareError("BANNER_URL_IS_NOT_VALID")
+
+var ErrorAccentColorIsNotValid = ape.DeclareError("ACCENT_COLOR_IS_NOT_VALID")
+
+var ErrorDefaultAvatarIsNotValid = ape.DeclareError("DEFAULT_AVATAR_IS_NOT_VALID")
@@ -0,0 +1,43 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+type FilterParams struct {
+	Status        *string
+	Type          *string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+func (s Service) FilterEvents(ctx context.Context, params FilterParams, offset, limit int32) (entity.InboxEventCollection, error) {
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	collection, err := s.db.FilterInboxEvents(ctx, params, uint(offset), uint(limit))
+	if err != nil {
+		return entity.InboxEventCollection{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("filtering inbox events: %w", err),
+		)
+	}
+
+	return collection, nil
+}
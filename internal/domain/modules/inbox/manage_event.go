@@ -0,0 +1,80 @@
+package inbox
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/umisto/kafkakit/box"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+)
+
+func (s Service) GetEvent(ctx context.Context, id uuid.UUID) (box.InboxEvent, error) {
+	ev, err := s.inbox.GetInboxEventByID(ctx, id)
+	switch {
+	case errors.Is(err, sql.ErrNoRows) || (err == nil && ev.ID == uuid.Nil):
+		return box.InboxEvent{}, errx.ErrorInboxEventNotFound.Raise(
+			fmt.Errorf("inbox event '%s' does not exist", id),
+		)
+	case err != nil:
+		return box.InboxEvent{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("getting inbox event '%s': %w", id, err),
+		)
+	}
+
+	return ev, nil
+}
+
+// RequeueEvent moves a failed event back to pending, so the inbox worker picks it up right away.
+// Its attempts are reset, a replayed event gets the full number of retries again.
+func (s Service) RequeueEvent(ctx context.Context, id uuid.UUID) (box.InboxEvent, error) {
+	ev, err := s.GetEvent(ctx, id)
+	if err != nil {
+		return box.InboxEvent{}, err
+	}
+
+	if ev.Status != box.InboxStatusFailed {
+		return box.InboxEvent{}, errx.ErrorInboxEventStatusConflict.Raise(
+			fmt.Errorf("only failed inbox events can be requeued, event '%s' is %s", id, ev.Status),
+		)
+	}
+
+	return s.updateEvent(ctx, id, func() ([]box.InboxEvent, error) {
+		return s.db.RequeueFailedInboxEvents(ctx, []uuid.UUID{id})
+	})
+}
+
+// SkipEvent marks a pending or failed event as processed without applying it.
+func (s Service) SkipEvent(ctx context.Context, id uuid.UUID) (box.InboxEvent, error) {
+	ev, err := s.GetEvent(ctx, id)
+	if err != nil {
+		return box.InboxEvent{}, err
+	}
+
+	if ev.Status != box.InboxStatusFailed && ev.Status != box.InboxStatusPending {
+		return box.InboxEvent{}, errx.ErrorInboxEventStatusConflict.Raise(
+			fmt.Errorf("only pending or failed inbox events can be skipped, event '%s' is %s", id, ev.Status),
+		)
+	}
+
+	return s.updateEvent(ctx, id, func() ([]box.InboxEvent, error) {
+		return s.inbox.MarkInboxEventsAsProcessed(ctx, []uuid.UUID{id})
+	})
+}
+
+func (s Service) updateEvent(ctx context.Context, id uuid.UUID, update func() ([]box.InboxEvent, error)) (box.InboxEvent, error) {
+	res, err := update()
+	if err != nil {
+		return box.InboxEvent{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("updating inbox event '%s': %w", id, err),
+		)
+	}
+
+	if len(res) != 1 {
+		return s.GetEvent(ctx, id)
+	}
+
+	return res[0], nil
+}
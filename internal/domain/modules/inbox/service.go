@@ -0,0 +1,38 @@
+package inbox
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/umisto/kafkakit/box"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+)
+
+// Service lets admins inspect inbox events and push stuck ones forward by hand.
+type Service struct {
+	db    database
+	inbox inbox
+}
+
+func New(db database, inbox inbox) Service {
+	return Service{
+		db:    db,
+		inbox: inbox,
+	}
+}
+
+type database interface {
+	FilterInboxEvents(
+		ctx context.Context,
+		params FilterParams,
+		offset uint,
+		limit uint,
+	) (entity.InboxEventCollection, error)
+
+	RequeueFailedInboxEvents(ctx context.Context, ids []uuid.UUID) ([]box.InboxEvent, error)
+}
+
+type inbox interface {
+	GetInboxEventByID(ctx context.Context, id uuid.UUID) (box.InboxEvent, error)
+	MarkInboxEventsAsProcessed(ctx context.Context, ids []uuid.UUID) ([]box.InboxEvent, error)
+}
@@ -0,0 +1,84 @@
+package profile
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/tracing"
+)
+
+// GetProfilesBatch looks up visible profiles by account ids and usernames at once. Duplicate
+// identifiers are ignored and a profile matched by both its id and username is returned once.
+func (s Service) GetProfilesBatch(ctx context.Context, ids []uuid.UUID, usernames []string) (entity.ProfileBatch, error) {
+	ctx, span := tracing.Start(ctx, "profile.GetProfilesBatch")
+	defer span.End()
+
+	ids = slices.Clone(ids)
+	slices.SortFunc(ids, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+	ids = slices.Compact(ids)
+
+	usernames = slices.Clone(usernames)
+	slices.Sort(usernames)
+	usernames = slices.Compact(usernames)
+
+	if len(ids)+len(usernames) > s.cfg.BatchLimit {
+		return entity.ProfileBatch{}, errx.ErrorBatchTooLarge.Raise(
+			fmt.Errorf("batch of %d identifiers exceeds the limit of %d", len(ids)+len(usernames), s.cfg.BatchLimit),
+		)
+	}
+
+	res := entity.ProfileBatch{
+		Data:             make([]entity.Profile, 0, len(ids)+len(usernames)),
+		MissingIDs:       make([]uuid.UUID, 0),
+		MissingUsernames: make([]string, 0),
+	}
+	seen := make(map[uuid.UUID]bool, len(ids)+len(usernames))
+
+	if len(ids) > 0 {
+		profiles, err := s.db.GetProfilesByAccountIDs(ctx, ids)
+		if err != nil {
+			return entity.ProfileBatch{}, errx.ErrorInternal.Raise(
+				fmt.Errorf("getting profiles by %d account ids: %w", len(ids), err),
+			)
+		}
+
+		for _, p := range profiles {
+			seen[p.AccountID] = true
+			res.Data = append(res.Data, p)
+		}
+		for _, id := range ids {
+			if !seen[id] {
+				res.MissingIDs = append(res.MissingIDs, id)
+			}
+		}
+	}
+
+	if len(usernames) > 0 {
+		profiles, err := s.db.GetProfilesByUsernames(ctx, usernames)
+		if err != nil {
+			return entity.ProfileBatch{}, errx.ErrorInternal.Raise(
+				fmt.Errorf("getting profiles by %d usernames: %w", len(usernames), err),
+			)
+		}
+
+		found := make(map[string]bool, len(profiles))
+		for _, p := range profiles {
+			found[p.Username] = true
+			if !seen[p.AccountID] {
+				seen[p.AccountID] = true
+				res.Data = append(res.Data, p)
+			}
+		}
+		for _, username := range usernames {
+			if !found[username] {
+				res.MissingUsernames = append(res.MissingUsernames, username)
+			}
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,161 @@
+package profile
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/umisto/logium"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+)
+
+// batchDB looks profiles up like the repository does, skipping hidden ones, and records
+// the identifiers it was asked for.
+type batchDB struct {
+	database
+
+	profiles  []entity.Profile
+	ids       []uuid.UUID
+	usernames []string
+}
+
+func (f *batchDB) GetProfilesByAccountIDs(_ context.Context, ids []uuid.UUID) ([]entity.Profile, error) {
+	f.ids = append(f.ids, ids...)
+
+	var res []entity.Profile
+	for _, p := range f.profiles {
+		if !p.Hidden && slices.Contains(ids, p.AccountID) {
+			res = append(res, p)
+		}
+	}
+
+	return res, nil
+}
+
+func (f *batchDB) GetProfilesByUsernames(_ context.Context, usernames []string) ([]entity.Profile, error) {
+	f.usernames = append(f.usernames, usernames...)
+
+	var res []entity.Profile
+	for _, p := range f.profiles {
+		if !p.Hidden && slices.Contains(usernames, p.Username) {
+			res = append(res, p)
+		}
+	}
+
+	return res, nil
+}
+
+func TestGetProfilesBatch(t *testing.T) {
+	alice := entity.Profile{AccountID: uuid.New(), Username: "alice"}
+	bob := entity.Profile{AccountID: uuid.New(), Username: "bob"}
+	hidden := entity.Profile{AccountID: uuid.New(), Username: "hidden", Hidden: true}
+	unknown := uuid.New()
+
+	tests := []struct {
+		name             string
+		ids              []uuid.UUID
+		usernames        []string
+		wantData         []uuid.UUID
+		wantMissingIDs   []uuid.UUID
+		wantMissingNames []string
+		wantQueried      int
+		wantErr          bool
+	}{
+		{
+			name:        "duplicate ids are looked up once",
+			ids:         []uuid.UUID{alice.AccountID, bob.AccountID, alice.AccountID},
+			wantData:    []uuid.UUID{alice.AccountID, bob.AccountID},
+			wantQueried: 2,
+		},
+		{
+			name:        "profile matched by id and username is returned once",
+			ids:         []uuid.UUID{alice.AccountID},
+			usernames:   []string{"alice", "bob", "bob"},
+			wantData:    []uuid.UUID{alice.AccountID, bob.AccountID},
+			wantQueried: 3,
+		},
+		{
+			name:             "missing and hidden profiles are reported missing",
+			ids:              []uuid.UUID{unknown, hidden.AccountID, bob.AccountID, unknown},
+			usernames:        []string{"hidden", "nobody"},
+			wantData:         []uuid.UUID{bob.AccountID},
+			wantMissingIDs:   []uuid.UUID{unknown, hidden.AccountID},
+			wantMissingNames: []string{"hidden", "nobody"},
+			wantQueried:      5,
+		},
+		{
+			name:        "duplicates do not count against the limit",
+			ids:         []uuid.UUID{alice.AccountID, alice.AccountID, alice.AccountID, alice.AccountID},
+			usernames:   []string{"bob", "bob", "bob"},
+			wantData:    []uuid.UUID{alice.AccountID, bob.AccountID},
+			wantQueried: 2,
+		},
+		{
+			name:      "batch over the limit",
+			ids:       []uuid.UUID{alice.AccountID, bob.AccountID, unknown},
+			usernames: []string{"carol", "dave", "erin"},
+			wantErr:   true,
+		},
+		{
+			name: "empty batch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &batchDB{profiles: []entity.Profile{alice, bob, hidden}}
+			s := New(logium.NewLogger("debug", "text"), db, &fakeEvent{}, nil, Config{BatchLimit: 5})
+
+			res, err := s.GetProfilesBatch(context.Background(), tt.ids, tt.usernames)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %d profiles", len(res.Data))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if queried := len(db.ids) + len(db.usernames); queried != tt.wantQueried {
+				t.Fatalf("expected %d identifiers to be queried, got %d", tt.wantQueried, queried)
+			}
+
+			var got []uuid.UUID
+			for _, p := range res.Data {
+				got = append(got, p.AccountID)
+			}
+			if !sameIDs(got, tt.wantData) {
+				t.Fatalf("expected profiles %v, got %v", tt.wantData, got)
+			}
+			if !sameIDs(res.MissingIDs, tt.wantMissingIDs) {
+				t.Fatalf("expected missing ids %v, got %v", tt.wantMissingIDs, res.MissingIDs)
+			}
+
+			missing := slices.Clone(res.MissingUsernames)
+			slices.Sort(missing)
+			if !slices.Equal(missing, tt.wantMissingNames) {
+				t.Fatalf("expected missing usernames %v, got %v", tt.wantMissingNames, res.MissingUsernames)
+			}
+			if res.Data == nil || res.MissingIDs == nil || res.MissingUsernames == nil {
+				t.Fatalf("expected empty lists rather than nil, got %+v", res)
+			}
+		})
+	}
+}
+
+// sameIDs compares the ids regardless of their order.
+func sameIDs(a, b []uuid.UUID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for _, id := range a {
+		if !slices.Contains(b, id) {
+			return false
+		}
+	}
+
+	return true
+}
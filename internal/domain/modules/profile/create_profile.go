@@ -3,19 +3,78 @@ package profile
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/umisto/profiles-svc/internal/domain/entity"
 	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/tracing"
 )
 
-func (s Service) CreateProfile(ctx context.Context, userID uuid.UUID, username string) (entity.Profile, error) {
-	profile, err := s.db.CreateProfile(ctx, userID, username)
+// CreateProfile creates the profile of a new account. It is idempotent: when the account already
+// has a profile, e.g. because the event was redelivered, the existing one is returned and no
+// event is written. usernameUpdatedAt may be zero if the source does not know it. A hidden profile,
+// e.g. of an account created as banned, is hidden before its created event is written.
+func (s Service) CreateProfile(
+	ctx context.Context,
+	userID uuid.UUID,
+	username string,
+	usernameUpdatedAt time.Time,
+	hidden bool,
+) (entity.Profile, error) {
+	ctx, span := tracing.Start(ctx, "profile.CreateProfile")
+	defer span.End()
+
+	var profile entity.Profile
+
+	err := s.db.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		profile, err = s.db.CreateProfile(ctx, userID, username, timePtr(usernameUpdatedAt))
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("creating profile for user '%s': %w", userID, err),
+			)
+		}
+
+		if profile.IsNil() {
+			profile, err = s.db.GetProfileByAccountID(ctx, userID)
+			if err != nil {
+				return errx.ErrorInternal.Raise(
+					fmt.Errorf("getting existing profile for user '%s': %w", userID, err),
+				)
+			}
+
+			return nil
+		}
+
+		if hidden {
+			profile, err = s.db.UpdateProfileHidden(ctx, userID, true)
+			if err != nil {
+				return errx.ErrorInternal.Raise(
+					fmt.Errorf("hiding profile for user '%s': %w", userID, err),
+				)
+			}
+		}
+
+		if err = s.event.WriteProfileCreated(ctx, profile); err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("writing profile created event for user '%s': %w", userID, err),
+			)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return entity.Profile{}, errx.ErrorInternal.Raise(
-			fmt.Errorf("creating profile for user '%s': %w", userID, err),
-		)
+		return entity.Profile{}, err
 	}
 
 	return profile, nil
 }
+
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
@@ -0,0 +1,106 @@
+package profile
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"slices"
+
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/imaging"
+	"github.com/umisto/profiles-svc/internal/tracing"
+)
+
+const (
+	DefaultAvatarStyleIdenticon = "identicon"
+	DefaultAvatarStyleInitials  = "initials"
+
+	DefaultAvatarFormatPNG = "png"
+	DefaultAvatarFormatSVG = "svg"
+
+	DefaultAvatarSize    = 256
+	MinDefaultAvatarSize = 16
+	MaxDefaultAvatarSize = 1024
+)
+
+var (
+	DefaultAvatarStyles  = []string{DefaultAvatarStyleIdenticon, DefaultAvatarStyleInitials}
+	DefaultAvatarFormats = []string{DefaultAvatarFormatPNG, DefaultAvatarFormatSVG}
+)
+
+type DefaultAvatarParams struct {
+	Style  string
+	Format string
+	// Size is the side of a PNG in pixels, SVGs scale freely and ignore it.
+	Size int
+}
+
+type DefaultAvatar struct {
+	ContentType string
+	Data        []byte
+}
+
+// GenerateDefaultAvatar draws the placeholder avatar of a profile. Identicons are keyed by the
+// account id alone and never change, initials follow the username.
+func (s Service) GenerateDefaultAvatar(ctx context.Context, profile entity.Profile, params DefaultAvatarParams) (DefaultAvatar, error) {
+	_, span := tracing.Start(ctx, "profile.GenerateDefaultAvatar")
+	defer span.End()
+
+	if err := validateDefaultAvatar(params); err != nil {
+		return DefaultAvatar{}, err
+	}
+
+	seed := profile.AccountID[:]
+
+	if params.Format == DefaultAvatarFormatSVG {
+		if params.Style == DefaultAvatarStyleInitials {
+			return DefaultAvatar{ContentType: "image/svg+xml", Data: imaging.InitialsSVG(profile.Username, seed)}, nil
+		}
+
+		return DefaultAvatar{ContentType: "image/svg+xml", Data: imaging.IdenticonSVG(seed)}, nil
+	}
+
+	var img image.Image
+	switch params.Style {
+	case DefaultAvatarStyleInitials:
+		initials, err := imaging.Initials(profile.Username, seed, params.Size)
+		if err != nil {
+			return DefaultAvatar{}, errx.ErrorInternal.Raise(
+				fmt.Errorf("drawing initials avatar for user '%s': %w", profile.AccountID, err),
+			)
+		}
+		img = initials
+	default:
+		img = imaging.Identicon(seed, params.Size)
+	}
+
+	data, err := imaging.EncodePNG(img)
+	if err != nil {
+		return DefaultAvatar{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("encoding default avatar for user '%s': %w", profile.AccountID, err),
+		)
+	}
+
+	return DefaultAvatar{ContentType: "image/png", Data: data}, nil
+}
+
+func validateDefaultAvatar(params DefaultAvatarParams) error {
+	if !slices.Contains(DefaultAvatarStyles, params.Style) {
+		return errx.ErrorDefaultAvatarIsNotValid.Raise(
+			fmt.Errorf("default avatar style '%s' is not supported, expected one of %v", params.Style, DefaultAvatarStyles),
+		)
+	}
+	if !slices.Contains(DefaultAvatarFormats, params.Format) {
+		return errx.ErrorDefaultAvatarIsNotValid.Raise(
+			fmt.Errorf("default avatar format '%s' is not supported, expected one of %v", params.Format, DefaultAvatarFormats),
+		)
+	}
+	if params.Format == DefaultAvatarFormatPNG && (params.Size < MinDefaultAvatarSize || params.Size > MaxDefaultAvatarSize) {
+		return errx.ErrorDefaultAvatarIsNotValid.Raise(
+			fmt.Errorf("default avatar size %d is out of range [%d, %d]", params.Size, MinDefaultAvatarSize, MaxDefaultAvatarSize),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package profile
+
+import (
+	"bytes"
+	"context"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/umisto/logium"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+)
+
+func TestGenerateDefaultAvatar(t *testing.T) {
+	s := New(logium.NewLogger("debug", "text"), &fakeDB{}, &fakeEvent{}, nil, Config{})
+	profile := entity.Profile{AccountID: uuid.New(), Username: "john_doe"}
+
+	tests := []struct {
+		name            string
+		params          DefaultAvatarParams
+		wantContentType string
+		wantErr         bool
+	}{
+		{name: "identicon png", params: DefaultAvatarParams{Style: DefaultAvatarStyleIdenticon, Format: DefaultAvatarFormatPNG, Size: 64}, wantContentType: "image/png"},
+		{name: "initials png", params: DefaultAvatarParams{Style: DefaultAvatarStyleInitials, Format: DefaultAvatarFormatPNG, Size: 64}, wantContentType: "image/png"},
+		{name: "identicon svg", params: DefaultAvatarParams{Style: DefaultAvatarStyleIdenticon, Format: DefaultAvatarFormatSVG}, wantContentType: "image/svg+xml"},
+		{name: "initials svg", params: DefaultAvatarParams{Style: DefaultAvatarStyleInitials, Format: DefaultAvatarFormatSVG}, wantContentType: "image/svg+xml"},
+		{name: "smallest png", params: DefaultAvatarParams{Style: DefaultAvatarStyleIdenticon, Format: DefaultAvatarFormatPNG, Size: MinDefaultAvatarSize}, wantContentType: "image/png"},
+		{name: "largest png", params: DefaultAvatarParams{Style: DefaultAvatarStyleIdenticon, Format: DefaultAvatarFormatPNG, Size: MaxDefaultAvatarSize}, wantContentType: "image/png"},
+		{name: "svg ignores size", params: DefaultAvatarParams{Style: DefaultAvatarStyleIdenticon, Format: DefaultAvatarFormatSVG, Size: 100000}, wantContentType: "image/svg+xml"},
+		{name: "png too small", params: DefaultAvatarParams{Style: DefaultAvatarStyleIdenticon, Format: DefaultAvatarFormatPNG, Size: MinDefaultAvatarSize - 1}, wantErr: true},
+		{name: "png too large", params: DefaultAvatarParams{Style: DefaultAvatarStyleIdenticon, Format: DefaultAvatarFormatPNG, Size: MaxDefaultAvatarSize + 1}, wantErr: true},
+		{name: "unknown style", params: DefaultAvatarParams{Style: "robot", Format: DefaultAvatarFormatPNG, Size: 64}, wantErr: true},
+		{name: "unknown format", params: DefaultAvatarParams{Style: DefaultAvatarStyleIdenticon, Format: "gif", Size: 64}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			avatar, err := s.GenerateDefaultAvatar(context.Background(), profile, tt.params)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got a %s avatar", avatar.ContentType)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if avatar.ContentType != tt.wantContentType {
+				t.Fatalf("expected content type %s, got %s", tt.wantContentType, avatar.ContentType)
+			}
+
+			if tt.params.Format == DefaultAvatarFormatSVG {
+				if !strings.HasPrefix(string(avatar.Data), "<svg") {
+					t.Fatalf("expected an svg document, got %q", avatar.Data)
+				}
+				return
+			}
+
+			img, err := png.Decode(bytes.NewReader(avatar.Data))
+			if err != nil {
+				t.Fatalf("decoding png: %v", err)
+			}
+			if bounds := img.Bounds(); bounds.Dx() != tt.params.Size || bounds.Dy() != tt.params.Size {
+				t.Fatalf("expected a %dx%d image, got %dx%d", tt.params.Size, tt.params.Size, bounds.Dx(), bounds.Dy())
+			}
+		})
+	}
+}
+
+func TestGenerateDefaultAvatarIsStable(t *testing.T) {
+	s := New(logium.NewLogger("debug", "text"), &fakeDB{}, &fakeEvent{}, nil, Config{})
+	profile := entity.Profile{AccountID: uuid.New(), Username: "john_doe"}
+	params := DefaultAvatarParams{Style: DefaultAvatarStyleIdenticon, Format: DefaultAvatarFormatPNG, Size: 64}
+
+	first, err := s.GenerateDefaultAvatar(context.Background(), profile, params)
+	if err != nil {
+		t.Fatalf("GenerateDefaultAvatar: %v", err)
+	}
+
+	// identicons are keyed by the account id alone, a new username keeps the picture
+	profile.Username = "jane_roe"
+	renamed, err := s.GenerateDefaultAvatar(context.Background(), profile, params)
+	if err != nil {
+		t.Fatalf("GenerateDefaultAvatar: %v", err)
+	}
+	if !bytes.Equal(first.Data, renamed.Data) {
+		t.Fatalf("expected the identicon to survive a username change")
+	}
+
+	params.Style = DefaultAvatarStyleInitials
+	params.Format = DefaultAvatarFormatSVG
+	initials, err := s.GenerateDefaultAvatar(context.Background(), profile, params)
+	if err != nil {
+		t.Fatalf("GenerateDefaultAvatar: %v", err)
+	}
+	if !strings.Contains(string(initials.Data), ">JR</text>") {
+		t.Fatalf("expected the initials of the current username, got %s", initials.Data)
+	}
+
+	other := entity.Profile{AccountID: uuid.New(), Username: "jane_roe"}
+	params = DefaultAvatarParams{Style: DefaultAvatarStyleIdenticon, Format: DefaultAvatarFormatPNG, Size: 64}
+	another, err := s.GenerateDefaultAvatar(context.Background(), other, params)
+	if err != nil {
+		t.Fatalf("GenerateDefaultAvatar: %v", err)
+	}
+	if bytes.Equal(first.Data, another.Data) {
+		t.Fatalf("expected accounts to get different identicons")
+	}
+}
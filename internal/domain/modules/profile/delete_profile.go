@@ -0,0 +1,51 @@
+package profile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/tracing"
+)
+
+// DeleteProfile removes the profile of a deleted account, deleting a missing profile is not an error.
+func (s Service) DeleteProfile(ctx context.Context, accountID uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "profile.DeleteProfile")
+	defer span.End()
+
+	prefixes := s.imagePrefixes(accountID)
+
+	err := s.db.Transaction(ctx, func(ctx context.Context) error {
+		profile, err := s.db.GetProfileByAccountID(ctx, accountID)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("getting profile for user '%s': %w", accountID, err),
+			)
+		}
+		if profile.IsNil() {
+			return nil
+		}
+
+		if err = s.db.DeleteProfile(ctx, accountID); err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("deleting profile for user '%s': %w", accountID, err),
+			)
+		}
+
+		if err = s.event.WriteProfileDeleted(ctx, accountID); err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("writing profile deleted event for user '%s': %w", accountID, err),
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.deleteImages(ctx, accountID, prefixes)
+
+	return nil
+}
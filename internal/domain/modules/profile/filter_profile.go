@@ -3,22 +3,96 @@ package profile
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/umisto/profiles-svc/internal/domain/entity"
 	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/tracing"
 )
 
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+const (
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+	SortByUsername  = "username"
+)
+
+var SortFields = []string{SortByCreatedAt, SortByUpdatedAt, SortByUsername}
+
 type FilterParams struct {
 	UsernamePrefix  *string
 	PseudonymPrefix *string
-	Verified        *bool
+	Official        *bool
+
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	UpdatedAfter  *time.Time
+
+	Sort *FilterSort
+}
+
+// FilterSort orders the offset listing, cursor mode is always ordered by creation time, newest first.
+type FilterSort struct {
+	Field     string
+	Ascending bool
 }
 
 func (s Service) FilterProfile(ctx context.Context, params FilterParams, offset, limit int32) (entity.ProfileCollection, error) {
+	ctx, span := tracing.Start(ctx, "profile.FilterProfile")
+	defer span.End()
+
+	if params.Sort != nil && !slices.Contains(SortFields, params.Sort.Field) {
+		return entity.ProfileCollection{}, errx.ErrorSortIsNotValid.Raise(
+			fmt.Errorf("sort field '%s' is not supported, expected one of %v", params.Sort.Field, SortFields),
+		)
+	}
+
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
 	collection, err := s.db.FilterProfiles(ctx, params, uint(offset), uint(limit))
 	if err != nil {
 		return entity.ProfileCollection{}, errx.ErrorInternal.Raise(
-			fmt.Errorf("getting profile with username '%s': %w", *params.UsernamePrefix, err),
+			fmt.Errorf("filtering profiles: %w", err),
+		)
+	}
+
+	return collection, nil
+}
+
+// FilterProfileByCursor lists profiles in keyset mode, always newest first, so params.Sort is ignored.
+func (s Service) FilterProfileByCursor(
+	ctx context.Context,
+	params FilterParams,
+	cursor *entity.ProfileCursor,
+	limit int32,
+) (entity.ProfileCollection, error) {
+	ctx, span := tracing.Start(ctx, "profile.FilterProfileByCursor")
+	defer span.End()
+
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	collection, err := s.db.FilterProfilesByCursor(ctx, params, cursor, uint(limit))
+	if err != nil {
+		return entity.ProfileCollection{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("filtering profiles by cursor: %w", err),
 		)
 	}
 
@@ -3,13 +3,18 @@ package profile
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/umisto/profiles-svc/internal/domain/entity"
 	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/tracing"
 )
 
 func (s Service) GetProfileByID(ctx context.Context, userID uuid.UUID) (entity.Profile, error) {
+	ctx, span := tracing.Start(ctx, "profile.GetProfileByID")
+	defer span.End()
+
 	profile, err := s.db.GetProfileByAccountID(ctx, userID)
 	if err != nil {
 		return entity.Profile{}, errx.ErrorInternal.Raise(
@@ -26,7 +31,13 @@ func (s Service) GetProfileByID(ctx context.Context, userID uuid.UUID) (entity.P
 	return profile, nil
 }
 
+// GetProfileByUsername finds the profile currently holding the username. If nobody holds it,
+// a profile that gave it up within the grace period is returned instead, callers can tell
+// such a redirect by the returned profile having a different username.
 func (s Service) GetProfileByUsername(ctx context.Context, username string) (entity.Profile, error) {
+	ctx, span := tracing.Start(ctx, "profile.GetProfileByUsername")
+	defer span.End()
+
 	profile, err := s.db.GetProfileByUsername(ctx, username)
 	if err != nil {
 		return entity.Profile{}, errx.ErrorInternal.Raise(
@@ -34,6 +45,24 @@ func (s Service) GetProfileByUsername(ctx context.Context, username string) (ent
 		)
 	}
 
+	if profile.IsNil() {
+		retired, err := s.db.GetRetiredUsername(ctx, username, time.Now().UTC().Add(-s.cfg.UsernameGracePeriod))
+		if err != nil {
+			return entity.Profile{}, errx.ErrorInternal.Raise(
+				fmt.Errorf("getting retired username '%s': %w", username, err),
+			)
+		}
+
+		if !retired.IsNil() {
+			profile, err = s.db.GetProfileByAccountID(ctx, retired.AccountID)
+			if err != nil {
+				return entity.Profile{}, errx.ErrorInternal.Raise(
+					fmt.Errorf("getting profile for user '%s' by retired username '%s': %w", retired.AccountID, username, err),
+				)
+			}
+		}
+	}
+
 	if profile.IsNil() {
 		return entity.Profile{}, errx.ErrorProfileNotFound.Raise(
 			fmt.Errorf("profile with username '%s' does not exist", username),
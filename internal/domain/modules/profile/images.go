@@ -0,0 +1,160 @@
+package profile
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/imaging"
+)
+
+const (
+	// ImageMaxPixels bounds the decoded size of an upload, the file size alone does not.
+	ImageMaxPixels = 40_000_000
+
+	imageQuality     = 90
+	imageContentType = "image/jpeg"
+
+	imageKindAvatars = "avatars"
+)
+
+// imageRendition is one stored JPEG of an upload, named after its width.
+type imageRendition struct {
+	Width  int
+	Height int
+}
+
+// storeImage decodes an upload and stores its renditions under "<kind>/<account id>/<upload id>/<width>.jpg",
+// returning the URL of the widest one. Re-encoding leaves EXIF and other metadata behind.
+func (s Service) storeImage(
+	ctx context.Context,
+	kind string,
+	accountID uuid.UUID,
+	data []byte,
+	maxSize int64,
+	renditions []imageRendition,
+) (string, error) {
+	if s.blobs == nil {
+		return "", errx.ErrorInternal.Raise(
+			fmt.Errorf("file storage is not configured"),
+		)
+	}
+
+	if int64(len(data)) > maxSize {
+		return "", errx.ErrorImageTooLarge.Raise(
+			fmt.Errorf("image of %d bytes exceeds the limit of %d bytes", len(data), maxSize),
+		)
+	}
+
+	contentType := http.DetectContentType(data)
+	if !slices.Contains(imaging.ContentTypes, contentType) {
+		return "", errx.ErrorImageIsNotValid.Raise(
+			fmt.Errorf("image content type '%s' is not supported, expected one of %v", contentType, imaging.ContentTypes),
+		)
+	}
+
+	img, err := imaging.Decode(data, ImageMaxPixels)
+	if err != nil {
+		return "", errx.ErrorImageIsNotValid.Raise(
+			fmt.Errorf("decoding image for user '%s': %w", accountID, err),
+		)
+	}
+
+	dir := path.Join(imagePrefix(kind, accountID), uuid.NewString())
+	widest := renditions[0]
+
+	for _, r := range renditions {
+		out, err := imaging.EncodeJPEG(imaging.Cover(img, r.Width, r.Height), imageQuality)
+		if err != nil {
+			return "", errx.ErrorInternal.Raise(
+				fmt.Errorf("encoding %dx%d image for user '%s': %w", r.Width, r.Height, accountID, err),
+			)
+		}
+
+		if err = s.blobs.Put(ctx, imageKey(dir, r.Width), out, imageContentType); err != nil {
+			_ = s.blobs.DeleteAll(ctx, dir)
+			return "", errx.ErrorInternal.Raise(
+				fmt.Errorf("storing %dx%d image for user '%s': %w", r.Width, r.Height, accountID, err),
+			)
+		}
+
+		if r.Width > widest.Width {
+			widest = r
+		}
+	}
+
+	return s.blobs.URL(imageKey(dir, widest.Width)), nil
+}
+
+// dropImageUpload removes the upload an image URL points to, if it was issued for the account.
+// It is used once the URL is no longer referenced, failing only wastes space and is logged.
+func (s Service) dropImageUpload(ctx context.Context, kind string, accountID uuid.UUID, url *string) {
+	if url == nil {
+		return
+	}
+
+	if key, ok := s.managedImageKey(kind, accountID, *url); ok {
+		if err := s.blobs.DeleteAll(ctx, path.Dir(key)); err != nil {
+			s.log.WithError(err).Errorf("failed to delete %s upload '%s' of user '%s'", kind, path.Dir(key), accountID)
+		}
+	}
+}
+
+// imagePrefixes returns the storage prefixes of every uploaded image of the account.
+func (s Service) imagePrefixes(accountID uuid.UUID) []string {
+	if s.blobs == nil {
+		return nil
+	}
+
+	return []string{imagePrefix(imageKindAvatars, accountID)}
+}
+
+// deleteImages removes everything under the prefixes. It runs once the profile no longer
+// references the files, so a failure only leaves them orphaned and is logged.
+func (s Service) deleteImages(ctx context.Context, accountID uuid.UUID, prefixes []string) {
+	for _, prefix := range prefixes {
+		if err := s.blobs.DeleteAll(ctx, prefix); err != nil {
+			s.log.WithError(err).Errorf("failed to delete images '%s' of user '%s'", prefix, accountID)
+		}
+	}
+}
+
+// managedImageKey returns the storage key behind an image URL issued by an upload of the account,
+// false for any other URL, including uploads of other accounts.
+func (s Service) managedImageKey(kind string, accountID uuid.UUID, url string) (string, bool) {
+	if s.blobs == nil {
+		return "", false
+	}
+
+	prefix := imagePrefix(kind, accountID) + "/"
+
+	rest, ok := strings.CutPrefix(url, s.blobs.URL(prefix))
+	if !ok {
+		return "", false
+	}
+
+	// only "<upload id>/<file>" is issued, anything else could make cleanups reach other files
+	upload, file, ok := strings.Cut(rest, "/")
+	if !ok || file == "" || file == "." || file == ".." || strings.Contains(file, "/") {
+		return "", false
+	}
+	if id, err := uuid.Parse(upload); err != nil || id.String() != upload {
+		return "", false
+	}
+
+	return prefix + upload + "/" + file, true
+}
+
+func imagePrefix(kind string, accountID uuid.UUID) string {
+	return path.Join(kind, accountID.String())
+}
+
+func imageKey(dir string, width int) string {
+	return path.Join(dir, strconv.Itoa(width)+".jpg")
+}
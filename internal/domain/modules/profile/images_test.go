@@ -0,0 +1,215 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/umisto/logium"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+)
+
+// fakeDB keeps a single profile and tells whether the last transaction was committed.
+type fakeDB struct {
+	database
+
+	profile   entity.Profile
+	retired   []string
+	committed bool
+}
+
+func (f *fakeDB) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	f.committed = false
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	f.committed = true
+	return nil
+}
+
+func (f *fakeDB) GetProfileByAccountID(_ context.Context, accountID uuid.UUID) (entity.Profile, error) {
+	if f.profile.AccountID != accountID {
+		return entity.Profile{}, nil
+	}
+
+	return f.profile, nil
+}
+
+func (f *fakeDB) DeleteProfile(_ context.Context, _ uuid.UUID) error {
+	return nil
+}
+
+func (f *fakeDB) ResetProfile(_ context.Context, _ uuid.UUID, username *string) (entity.Profile, error) {
+	profile := f.profile
+	profile.Avatar = nil
+	if username != nil {
+		profile.Username = *username
+	}
+
+	return profile, nil
+}
+
+func (f *fakeDB) CreateRetiredUsername(_ context.Context, accountID uuid.UUID, username string) (entity.RetiredUsername, error) {
+	f.retired = append(f.retired, username)
+
+	return entity.RetiredUsername{AccountID: accountID, Username: username}, nil
+}
+
+func (f *fakeDB) CreateProfileReset(_ context.Context, input entity.ProfileReset) (entity.ProfileReset, error) {
+	return input, nil
+}
+
+// fakeEvent fails every write with err.
+type fakeEvent struct {
+	event
+
+	err error
+}
+
+func (f *fakeEvent) WriteProfileUpdated(_ context.Context, _ entity.Profile) error {
+	return f.err
+}
+
+func (f *fakeEvent) WriteProfileDeleted(_ context.Context, _ uuid.UUID) error {
+	return f.err
+}
+
+// fakeBlobs records deleted prefixes and whether the transaction was committed by then.
+type fakeBlobs struct {
+	db *fakeDB
+
+	deleted            []string
+	deletedUncommitted bool
+}
+
+func (f *fakeBlobs) Put(_ context.Context, _ string, _ []byte, _ string) error {
+	return nil
+}
+
+func (f *fakeBlobs) DeleteAll(_ context.Context, prefix string) error {
+	f.deleted = append(f.deleted, prefix)
+	if f.db != nil && !f.db.committed {
+		f.deletedUncommitted = true
+	}
+
+	return nil
+}
+
+func (f *fakeBlobs) URL(key string) string {
+	return "https://cdn.example.com/files/" + key
+}
+
+func TestManagedImageKey(t *testing.T) {
+	accountID := uuid.New()
+	upload := uuid.NewString()
+	base := "https://cdn.example.com/files/avatars/" + accountID.String() + "/"
+
+	s := New(logium.NewLogger("debug", "text"), &fakeDB{}, &fakeEvent{}, &fakeBlobs{}, Config{})
+
+	tests := []struct {
+		name   string
+		url    string
+		want   string
+		wantOK bool
+	}{
+		{name: "issued upload", url: base + upload + "/512.jpg", want: "avatars/" + accountID.String() + "/" + upload + "/512.jpg", wantOK: true},
+		{name: "upload of another account", url: "https://cdn.example.com/files/avatars/" + uuid.NewString() + "/" + upload + "/512.jpg"},
+		{name: "external url", url: "https://example.com/avatar.png"},
+		{name: "no file", url: base + upload},
+		{name: "empty file", url: base + upload + "/"},
+		{name: "nested file", url: base + upload + "/a/512.jpg"},
+		{name: "parent file", url: base + upload + "/.."},
+		{name: "dot file", url: base + upload + "/."},
+		{name: "parent upload", url: base + "../" + uuid.NewString() + "/512.jpg"},
+		{name: "traversal out of the account", url: base + "../../" + upload + "/512.jpg"},
+		{name: "upload is not a uuid", url: base + "avatar/512.jpg"},
+		{name: "upload is not a canonical uuid", url: base + "{" + upload + "}/512.jpg"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := s.managedImageKey(imageKindAvatars, accountID, tt.url)
+			if ok != tt.wantOK || got != tt.want {
+				t.Fatalf("managedImageKey(%q): expected %q, %t, got %q, %t", tt.url, tt.want, tt.wantOK, got, ok)
+			}
+		})
+	}
+
+	if _, ok := New(logium.NewLogger("debug", "text"), &fakeDB{}, &fakeEvent{}, nil, Config{}).
+		managedImageKey(imageKindAvatars, accountID, base+upload+"/512.jpg"); ok {
+		t.Fatalf("expected no managed images without a storage")
+	}
+}
+
+func TestImageCleanupAfterCommit(t *testing.T) {
+	errBroker := errors.New("broker unavailable")
+
+	tests := []struct {
+		name     string
+		eventErr error
+		call     func(s Service, accountID uuid.UUID) error
+	}{
+		{
+			name: "delete",
+			call: func(s Service, accountID uuid.UUID) error {
+				return s.DeleteProfile(context.Background(), accountID)
+			},
+		},
+		{
+			name:     "delete rolled back",
+			eventErr: errBroker,
+			call: func(s Service, accountID uuid.UUID) error {
+				return s.DeleteProfile(context.Background(), accountID)
+			},
+		},
+		{
+			name: "reset",
+			call: func(s Service, accountID uuid.UUID) error {
+				_, err := s.ResetProfile(context.Background(), accountID, ResetParams{InitiatorID: uuid.New(), Reason: "spam"})
+				return err
+			},
+		},
+		{
+			name:     "reset rolled back",
+			eventErr: errBroker,
+			call: func(s Service, accountID uuid.UUID) error {
+				_, err := s.ResetProfile(context.Background(), accountID, ResetParams{InitiatorID: uuid.New(), Reason: "spam"})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountID := uuid.New()
+			avatar := "https://cdn.example.com/files/avatars/" + accountID.String() + "/" + uuid.NewString() + "/512.jpg"
+
+			db := &fakeDB{profile: entity.Profile{AccountID: accountID, Username: "alice", Avatar: &avatar}}
+			blobs := &fakeBlobs{db: db}
+			s := New(logium.NewLogger("debug", "text"), db, &fakeEvent{err: tt.eventErr}, blobs, Config{})
+
+			err := tt.call(s, accountID)
+			if tt.eventErr != nil {
+				if err == nil {
+					t.Fatalf("expected the transaction to fail")
+				}
+				if len(blobs.deleted) != 0 {
+					t.Fatalf("expected images of a rolled back change to stay, got %v deleted", blobs.deleted)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(blobs.deleted) != 1 || blobs.deleted[0] != "avatars/"+accountID.String() {
+				t.Fatalf("expected the avatars of the account to be deleted, got %v", blobs.deleted)
+			}
+			if blobs.deletedUncommitted {
+				t.Fatalf("expected images to be deleted after the commit")
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package profile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/tracing"
+)
+
+type ResetParams struct {
+	InitiatorID   uuid.UUID
+	Reason        string
+	ResetUsername bool
+}
+
+func (s Service) ResetProfile(ctx context.Context, accountID uuid.UUID, params ResetParams) (entity.Profile, error) {
+	ctx, span := tracing.Start(ctx, "profile.ResetProfile")
+	defer span.End()
+
+	var username *string
+	if params.ResetUsername {
+		generated := generateUsername()
+		username = &generated
+	}
+
+	prefixes := s.imagePrefixes(accountID)
+
+	var profile entity.Profile
+
+	err := s.db.Transaction(ctx, func(ctx context.Context) error {
+		current, err := s.db.GetProfileByAccountID(ctx, accountID)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("getting profile for user '%s': %w", accountID, err),
+			)
+		}
+
+		if current.IsNil() {
+			return errx.ErrorProfileNotFound.Raise(
+				fmt.Errorf("profile for user '%s' does not exist", accountID),
+			)
+		}
+
+		// the replaced username goes through the same history as a change by the user
+		if username != nil {
+			if _, err = s.db.CreateRetiredUsername(ctx, accountID, current.Username); err != nil {
+				return errx.ErrorInternal.Raise(
+					fmt.Errorf("retiring username '%s' of user '%s': %w", current.Username, accountID, err),
+				)
+			}
+		}
+
+		profile, err = s.db.ResetProfile(ctx, accountID, username)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("resetting profile for user '%s': %w", accountID, err),
+			)
+		}
+
+		_, err = s.db.CreateProfileReset(ctx, entity.ProfileReset{
+			AccountID:     accountID,
+			InitiatorID:   params.InitiatorID,
+			Reason:        params.Reason,
+			UsernameReset: params.ResetUsername,
+		})
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("recording profile reset for user '%s': %w", accountID, err),
+			)
+		}
+
+		if err = s.event.WriteProfileUpdated(ctx, profile); err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("writing profile updated event for user '%s': %w", accountID, err),
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Profile{}, err
+	}
+
+	// a reset avatar must stop being served, not just stop being referenced
+	s.deleteImages(ctx, accountID, prefixes)
+
+	return profile, nil
+}
+
+// generateUsername returns a random placeholder username like "user_1a2b3c4d5e6f".
+func generateUsername() string {
+	return "user_" + strings.ReplaceAll(uuid.NewString(), "-", "")[:12]
+}
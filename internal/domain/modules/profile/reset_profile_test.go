@@ -0,0 +1,63 @@
+package profile
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/umisto/logium"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+)
+
+func TestResetProfileUsername(t *testing.T) {
+	tests := []struct {
+		name          string
+		resetUsername bool
+		wantRetired   []string
+	}{
+		{name: "username is kept"},
+		{name: "replaced username is retired", resetUsername: true, wantRetired: []string{"alice"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountID := uuid.New()
+			db := &fakeDB{profile: entity.Profile{AccountID: accountID, Username: "alice"}}
+			s := New(logium.NewLogger("debug", "text"), db, &fakeEvent{}, nil, Config{})
+
+			profile, err := s.ResetProfile(context.Background(), accountID, ResetParams{
+				InitiatorID:   uuid.New(),
+				Reason:        "abusive username",
+				ResetUsername: tt.resetUsername,
+			})
+			if err != nil {
+				t.Fatalf("ResetProfile: %v", err)
+			}
+
+			if tt.resetUsername && (profile.Username == "alice" || !strings.HasPrefix(profile.Username, "user_")) {
+				t.Fatalf("expected a generated username, got %s", profile.Username)
+			}
+			if !tt.resetUsername && profile.Username != "alice" {
+				t.Fatalf("expected the username to be kept, got %s", profile.Username)
+			}
+			if !slices.Equal(db.retired, tt.wantRetired) {
+				t.Fatalf("expected retired usernames %v, got %v", tt.wantRetired, db.retired)
+			}
+		})
+	}
+}
+
+func TestResetProfileNotFound(t *testing.T) {
+	db := &fakeDB{}
+	s := New(logium.NewLogger("debug", "text"), db, &fakeEvent{}, nil, Config{})
+
+	_, err := s.ResetProfile(context.Background(), uuid.New(), ResetParams{ResetUsername: true})
+	if err == nil {
+		t.Fatalf("expected an error for a missing profile")
+	}
+	if len(db.retired) != 0 {
+		t.Fatalf("expected nothing to be retired, got %v", db.retired)
+	}
+}
@@ -0,0 +1,48 @@
+package profile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/tracing"
+)
+
+const (
+	MinSearchQueryLength = 2
+	MaxSearchQueryLength = 128
+)
+
+func (s Service) SearchProfiles(ctx context.Context, query string, offset, limit int32) (entity.ProfileSearchCollection, error) {
+	ctx, span := tracing.Start(ctx, "profile.SearchProfiles")
+	defer span.End()
+
+	query = strings.TrimSpace(query)
+	if l := utf8.RuneCountInString(query); l < MinSearchQueryLength || l > MaxSearchQueryLength {
+		return entity.ProfileSearchCollection{}, errx.ErrorSearchQueryIsNotValid.Raise(
+			fmt.Errorf("search query must be from %d to %d characters long", MinSearchQueryLength, MaxSearchQueryLength),
+		)
+	}
+
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	collection, err := s.db.SearchProfiles(ctx, query, uint(offset), uint(limit))
+	if err != nil {
+		return entity.ProfileSearchCollection{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("searching profiles by '%s': %w", query, err),
+		)
+	}
+
+	return collection, nil
+}
@@ -2,31 +2,89 @@ package profile
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/umisto/logium"
 	"github.com/umisto/profiles-svc/internal/domain/entity"
 )
 
 type Service struct {
-	db database
+	log   logium.Logger
+	db    database
+	event event
+	blobs blobs
+	cfg   Config
 }
 
-func New(db database) Service {
+// Config holds tunable limits of the profile module, zero values fall back to defaults.
+type Config struct {
+	BatchLimit int
+
+	// UsernameGracePeriod is how long a retired username keeps resolving to its former owner.
+	UsernameGracePeriod time.Duration
+
+	// AvatarSizes are the sides in pixels of the square avatar thumbnails made of an upload.
+	AvatarSizes []int
+	// AvatarMaxSize limits an uploaded avatar file in bytes.
+	AvatarMaxSize int64
+	// AllowExternalAvatars lets users set any avatar URL, not only the ones issued by an upload.
+	AllowExternalAvatars bool
+}
+
+const (
+	DefaultBatchLimit          = 100
+	DefaultUsernameGracePeriod = 30 * 24 * time.Hour
+	DefaultAvatarMaxSize       = 5 << 20
+)
+
+var DefaultAvatarSizes = []int{512, 256, 128, 64}
+
+// New creates the profile service, blobs may be nil when image uploads are not served.
+func New(log logium.Logger, db database, event event, blobs blobs, cfg Config) Service {
+	if cfg.BatchLimit <= 0 {
+		cfg.BatchLimit = DefaultBatchLimit
+	}
+	if cfg.UsernameGracePeriod <= 0 {
+		cfg.UsernameGracePeriod = DefaultUsernameGracePeriod
+	}
+	if len(cfg.AvatarSizes) == 0 {
+		cfg.AvatarSizes = DefaultAvatarSizes
+	}
+	if cfg.AvatarMaxSize <= 0 {
+		cfg.AvatarMaxSize = DefaultAvatarMaxSize
+	}
+
 	return Service{
-		db: db,
+		log:   log,
+		db:    db,
+		event: event,
+		blobs: blobs,
+		cfg:   cfg,
 	}
 }
 
 type database interface {
-	CreateProfile(ctx context.Context, userID uuid.UUID, username string) (entity.Profile, error)
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	CreateProfile(ctx context.Context, userID uuid.UUID, username string, usernameUpdatedAt *time.Time) (entity.Profile, error)
 
 	GetProfileByAccountID(ctx context.Context, userID uuid.UUID) (entity.Profile, error)
 	GetProfileByUsername(ctx context.Context, username string) (entity.Profile, error)
+	GetProfilesByAccountIDs(ctx context.Context, ids []uuid.UUID) ([]entity.Profile, error)
+	GetProfilesByUsernames(ctx context.Context, usernames []string) ([]entity.Profile, error)
 
 	UpdateProfile(ctx context.Context, userID uuid.UUID, params UpdateParams) (entity.Profile, error)
 
-	UpdateProfileUsername(ctx context.Context, userID uuid.UUID, username string) (entity.Profile, error)
+	UpdateProfileUsername(ctx context.Context, userID uuid.UUID, username string, usernameUpdatedAt *time.Time) (entity.Profile, error)
 	UpdateProfileOfficial(ctx context.Context, userID uuid.UUID, official bool) (entity.Profile, error)
+	UpdateProfileHidden(ctx context.Context, userID uuid.UUID, hidden bool) (entity.Profile, error)
+
+	CreateRetiredUsername(ctx context.Context, userID uuid.UUID, username string) (entity.RetiredUsername, error)
+	GetRetiredUsername(ctx context.Context, username string, after time.Time) (entity.RetiredUsername, error)
+
+	ResetProfile(ctx context.Context, userID uuid.UUID, username *string) (entity.Profile, error)
+	CreateProfileReset(ctx context.Context, input entity.ProfileReset) (entity.ProfileReset, error)
 
 	DeleteProfile(ctx context.Context, userID uuid.UUID) error
 
@@ -36,4 +94,29 @@ type database interface {
 		offset uint,
 		limit uint,
 	) (entity.ProfileCollection, error)
+	FilterProfilesByCursor(
+		ctx context.Context,
+		params FilterParams,
+		cursor *entity.ProfileCursor,
+		limit uint,
+	) (entity.ProfileCollection, error)
+	SearchProfiles(
+		ctx context.Context,
+		query string,
+		offset uint,
+		limit uint,
+	) (entity.ProfileSearchCollection, error)
+}
+
+type event interface {
+	WriteProfileCreated(ctx context.Context, profile entity.Profile) error
+	WriteProfileUpdated(ctx context.Context, profile entity.Profile) error
+	WriteProfileOfficialChanged(ctx context.Context, profile entity.Profile) error
+	WriteProfileDeleted(ctx context.Context, accountID uuid.UUID) error
+}
+
+type blobs interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	DeleteAll(ctx context.Context, prefix string) error
+	URL(key string) string
 }
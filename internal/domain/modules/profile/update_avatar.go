@@ -0,0 +1,58 @@
+package profile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/tracing"
+)
+
+type ImageParams struct {
+	Image []byte
+
+	// Version is the profile version the caller has seen, nil skips the check.
+	Version *int64
+}
+
+// UpdateProfileAvatar makes an uploaded image the profile avatar. The image is re-encoded into
+// square JPEG thumbnails of every configured size, which leaves EXIF and other metadata behind.
+// The avatar URL points to the largest thumbnail, the others lie beside it as "<size>.jpg".
+func (s Service) UpdateProfileAvatar(ctx context.Context, accountID uuid.UUID, params ImageParams) (entity.Profile, error) {
+	ctx, span := tracing.Start(ctx, "profile.UpdateProfileAvatar")
+	defer span.End()
+
+	current, err := s.GetProfileByID(ctx, accountID)
+	if err != nil {
+		return entity.Profile{}, err
+	}
+
+	if params.Version != nil && *params.Version != current.Version {
+		return entity.Profile{}, errx.ErrorProfileVersionConflict.Raise(
+			fmt.Errorf("profile for user '%s' has version %d, expected %d", accountID, current.Version, *params.Version),
+		)
+	}
+
+	renditions := make([]imageRendition, 0, len(s.cfg.AvatarSizes))
+	for _, size := range s.cfg.AvatarSizes {
+		renditions = append(renditions, imageRendition{Width: size, Height: size})
+	}
+
+	url, err := s.storeImage(ctx, imageKindAvatars, accountID, params.Image, s.cfg.AvatarMaxSize, renditions)
+	if err != nil {
+		return entity.Profile{}, err
+	}
+
+	profile, err := s.UpdateProfile(ctx, accountID, UpdateParams{
+		Avatar:  SetField(url),
+		Version: params.Version,
+	})
+	if err != nil {
+		s.dropImageUpload(ctx, imageKindAvatars, accountID, &url)
+		return entity.Profile{}, err
+	}
+
+	return profile, nil
+}
@@ -5,67 +5,281 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/umisto/profiles-svc/internal/domain/entity"
 	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/tracing"
 )
 
+// Field is an attribute of a partial update. The zero Field keeps the stored value, a set one
+// replaces it with Value, where nil clears it.
+type Field[T any] struct {
+	Set   bool
+	Value *T
+}
+
+// SetField replaces the attribute with v.
+func SetField[T any](v T) Field[T] {
+	return Field[T]{Set: true, Value: &v}
+}
+
+// ClearField sets the attribute to null.
+func ClearField[T any]() Field[T] {
+	return Field[T]{Set: true}
+}
+
 type UpdateParams struct {
-	Pseudonym   *string
-	Description *string
-	Avatar      *string
+	Pseudonym   Field[string]
+	Description Field[string]
+	Avatar      Field[string]
+	Banner      Field[string]
+	AccentColor Field[string]
+	Sex         Field[string]
+	BirthDate   Field[time.Time]
+
+	// Version is the profile version the caller has seen, the update is rejected
+	// if the profile changed since then. Nil skips the check.
+	Version *int64
 }
 
 func (s Service) UpdateProfile(ctx context.Context, accountID uuid.UUID, input UpdateParams) (entity.Profile, error) {
+	ctx, span := tracing.Start(ctx, "profile.UpdateProfile")
+	defer span.End()
+
 	p, err := s.GetProfileByID(ctx, accountID)
 	if err != nil {
 		return entity.Profile{}, err
 	}
 
-	if input == (UpdateParams{}) {
+	if input.Version != nil && *input.Version != p.Version {
+		return entity.Profile{}, errx.ErrorProfileVersionConflict.Raise(
+			fmt.Errorf("profile for user '%s' has version %d, expected %d", accountID, p.Version, *input.Version),
+		)
+	}
+
+	fields := input
+	fields.Version = nil
+	if fields == (UpdateParams{}) {
 		return p, nil
 	}
 
-	profile, err := s.db.UpdateProfile(ctx, accountID, input)
+	if input.Avatar.Value != nil {
+		if err = s.validateAvatarURL(accountID, *input.Avatar.Value); err != nil {
+			return entity.Profile{}, err
+		}
+	}
+	if input.Banner.Value != nil {
+		if err = validateBannerURL(*input.Banner.Value); err != nil {
+			return entity.Profile{}, err
+		}
+	}
+	if input.AccentColor.Value != nil {
+		color, err := normalizeAccentColor(*input.AccentColor.Value)
+		if err != nil {
+			return entity.Profile{}, err
+		}
+		input.AccentColor = SetField(color)
+	}
+	if input.Sex.Value != nil {
+		if err = validateSex(*input.Sex.Value); err != nil {
+			return entity.Profile{}, err
+		}
+	}
+	if input.BirthDate.Value != nil {
+		if err = validateBirthDate(*input.BirthDate.Value, time.Now().UTC()); err != nil {
+			return entity.Profile{}, err
+		}
+	}
+
+	var profile entity.Profile
+
+	err = s.db.Transaction(ctx, func(ctx context.Context) error {
+		profile, err = s.db.UpdateProfile(ctx, accountID, input)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("updating profile for user '%s': %w", accountID, err),
+			)
+		}
+		if profile.IsNil() {
+			return errx.ErrorProfileVersionConflict.Raise(
+				fmt.Errorf("profile for user '%s' was modified concurrently", accountID),
+			)
+		}
+
+		if err = s.event.WriteProfileUpdated(ctx, profile); err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("writing profile updated event for user '%s': %w", accountID, err),
+			)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return entity.Profile{}, errx.ErrorInternal.Raise(
-			fmt.Errorf("updating profile for user '%s': %w", accountID, err),
-		)
+		return entity.Profile{}, err
+	}
+
+	// uploads replaced or cleared by this update are not referenced anymore
+	if input.Avatar.Set && !equalPtr(p.Avatar, profile.Avatar) {
+		s.dropImageUpload(ctx, imageKindAvatars, accountID, p.Avatar)
 	}
 
 	return profile, nil
 }
 
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
 func (s Service) UpdateProfileOfficial(ctx context.Context, accountID uuid.UUID, official bool) (entity.Profile, error) {
+	ctx, span := tracing.Start(ctx, "profile.UpdateProfileOfficial")
+	defer span.End()
+
 	_, err := s.GetProfileByID(ctx, accountID)
 	if err != nil {
 		return entity.Profile{}, err
 	}
 
-	profile, err := s.db.UpdateProfileOfficial(ctx, accountID, official)
+	var profile entity.Profile
+
+	err = s.db.Transaction(ctx, func(ctx context.Context) error {
+		profile, err = s.db.UpdateProfileOfficial(ctx, accountID, official)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("updating profile for user '%s': %w", accountID, err),
+			)
+		}
+
+		if err = s.event.WriteProfileOfficialChanged(ctx, profile); err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("writing profile official changed event for user '%s': %w", accountID, err),
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Profile{}, err
+	}
+
+	return profile, nil
+}
+
+// UpdateProfileUsername applies a username change made at usernameUpdatedAt. Changes older than
+// the last applied one are rejected with ErrorUsernameChangeOutdated, a zero time skips the check.
+func (s Service) UpdateProfileUsername(
+	ctx context.Context,
+	accountID uuid.UUID,
+	username string,
+	usernameUpdatedAt time.Time,
+) (entity.Profile, error) {
+	ctx, span := tracing.Start(ctx, "profile.UpdateProfileUsername")
+	defer span.End()
+
+	var profile entity.Profile
+
+	err := s.db.Transaction(ctx, func(ctx context.Context) error {
+		current, err := s.db.GetProfileByAccountID(ctx, accountID)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("getting profile for user '%s': %w", accountID, err),
+			)
+		}
+
+		if current.IsNil() {
+			return errx.ErrorProfileNotFound.Raise(
+				fmt.Errorf("profile for user '%s' does not exist", accountID),
+			)
+		}
+
+		if !usernameUpdatedAt.IsZero() && current.UsernameUpdatedAt != nil && !usernameUpdatedAt.After(*current.UsernameUpdatedAt) {
+			return errx.ErrorUsernameChangeOutdated.Raise(
+				fmt.Errorf(
+					"username change for user '%s' made at %s is older than the applied one made at %s",
+					accountID, usernameUpdatedAt, *current.UsernameUpdatedAt,
+				),
+			)
+		}
+
+		if current.Username != username {
+			if _, err = s.db.CreateRetiredUsername(ctx, accountID, current.Username); err != nil {
+				return errx.ErrorInternal.Raise(
+					fmt.Errorf("retiring username '%s' of user '%s': %w", current.Username, accountID, err),
+				)
+			}
+		}
+
+		profile, err = s.db.UpdateProfileUsername(ctx, accountID, username, timePtr(usernameUpdatedAt))
+		if err != nil {
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				return errx.ErrorUsernameChangeOutdated.Raise(
+					fmt.Errorf("username of user '%s' was changed concurrently by a newer change", accountID),
+				)
+			default:
+				return errx.ErrorInternal.Raise(
+					fmt.Errorf("updating username for user '%s': %w", accountID, err),
+				)
+			}
+		}
+
+		if err = s.event.WriteProfileUpdated(ctx, profile); err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("writing profile updated event for user '%s': %w", accountID, err),
+			)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return entity.Profile{}, errx.ErrorInternal.Raise(
-			fmt.Errorf("updating profile for user '%s': %w", accountID, err),
-		)
+		return entity.Profile{}, err
 	}
 
 	return profile, nil
 }
 
-func (s Service) UpdateProfileUsername(ctx context.Context, accountID uuid.UUID, username string) (entity.Profile, error) {
-	profile, err := s.db.UpdateProfileUsername(ctx, accountID, username)
+// UpdateProfileHidden follows the status of the account, an account without a profile is left as is
+// and the empty profile is returned.
+func (s Service) UpdateProfileHidden(ctx context.Context, accountID uuid.UUID, hidden bool) (entity.Profile, error) {
+	ctx, span := tracing.Start(ctx, "profile.UpdateProfileHidden")
+	defer span.End()
+
+	p, err := s.db.GetProfileByAccountID(ctx, accountID)
 	if err != nil {
-		switch {
-		case errors.Is(err, sql.ErrNoRows):
-			return entity.Profile{}, errx.ErrorProfileNotFound.Raise(
-				fmt.Errorf("profile for user '%s' does not exist", accountID),
+		return entity.Profile{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("getting profile for user '%s': %w", accountID, err),
+		)
+	}
+
+	if p.IsNil() || p.Hidden == hidden {
+		return p, nil
+	}
+
+	var profile entity.Profile
+
+	err = s.db.Transaction(ctx, func(ctx context.Context) error {
+		profile, err = s.db.UpdateProfileHidden(ctx, accountID, hidden)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("updating profile visibility for user '%s': %w", accountID, err),
 			)
-		default:
-			return entity.Profile{}, errx.ErrorInternal.Raise(
-				fmt.Errorf("updating username for user '%s': %w", accountID, err),
+		}
+
+		if err = s.event.WriteProfileUpdated(ctx, profile); err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("writing profile updated event for user '%s': %w", accountID, err),
 			)
 		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Profile{}, err
 	}
 
 	return profile, nil
@@ -0,0 +1,84 @@
+package profile
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+)
+
+// MinUserAge is the minimal age in full years a user must have to set a birth date.
+const MinUserAge = 12
+
+func validateSex(sex string) error {
+	if !slices.Contains(entity.Sexes, sex) {
+		return errx.ErrorSexIsNotValid.Raise(
+			fmt.Errorf("sex '%s' is not supported, expected one of %v", sex, entity.Sexes),
+		)
+	}
+
+	return nil
+}
+
+func validateBirthDate(birthDate time.Time, now time.Time) error {
+	if birthDate.After(now) || birthDate.Year() < 1900 {
+		return errx.ErrorBirthdateIsNotValid.Raise(
+			fmt.Errorf("birth date '%s' is out of allowed range", birthDate.Format(time.DateOnly)),
+		)
+	}
+
+	if birthDate.AddDate(MinUserAge, 0, 0).After(now) {
+		return errx.ErrorUserTooYoung.Raise(
+			fmt.Errorf("user must be at least %d years old, birth date '%s'", MinUserAge, birthDate.Format(time.DateOnly)),
+		)
+	}
+
+	return nil
+}
+
+// validateAvatarURL allows avatars issued by an upload of the account, any other URL only if
+// external avatars are allowed.
+func (s Service) validateAvatarURL(accountID uuid.UUID, url string) error {
+	if s.cfg.AllowExternalAvatars {
+		return nil
+	}
+
+	if _, ok := s.managedImageKey(imageKindAvatars, accountID, url); !ok {
+		return errx.ErrorAvatarURLNotAllowed.Raise(
+			fmt.Errorf("avatar '%s' was not uploaded by user '%s' and external avatars are not allowed", url, accountID),
+		)
+	}
+
+	return nil
+}
+
+// validateBannerURL allows absolute http and https URLs, banners are not uploaded to the service.
+func validateBannerURL(banner string) error {
+	u, err := url.Parse(banner)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errx.ErrorBannerURLIsNotValid.Raise(
+			fmt.Errorf("banner '%s' is not an absolute http or https url", banner),
+		)
+	}
+
+	return nil
+}
+
+var accentColorRegexp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// normalizeAccentColor checks the color is written as #RRGGBB and lowercases it.
+func normalizeAccentColor(color string) (string, error) {
+	if !accentColorRegexp.MatchString(color) {
+		return "", errx.ErrorAccentColorIsNotValid.Raise(
+			fmt.Errorf("accent color '%s' is not a #RRGGBB hex color", color),
+		)
+	}
+
+	return strings.ToLower(color), nil
+}
@@ -0,0 +1,77 @@
+package profile
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+)
+
+func TestNormalizeAccentColor(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{name: "lowercase", in: "#1a2b3c", want: "#1a2b3c"},
+		{name: "uppercase", in: "#1A2B3C", want: "#1a2b3c"},
+		{name: "mixed case", in: "#AbCdEf", want: "#abcdef"},
+		{name: "black", in: "#000000", want: "#000000"},
+		{name: "empty", in: "", wantErr: true},
+		{name: "no hash", in: "1a2b3c", wantErr: true},
+		{name: "short form", in: "#abc", wantErr: true},
+		{name: "with alpha", in: "#1a2b3c4d", wantErr: true},
+		{name: "not hex", in: "#1a2b3g", wantErr: true},
+		{name: "color name", in: "red", wantErr: true},
+		{name: "surrounding spaces", in: " #1a2b3c ", wantErr: true},
+		{name: "trailing newline", in: "#1a2b3c\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeAccentColor(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, errx.ErrorAccentColorIsNotValid) {
+					t.Fatalf("normalizeAccentColor(%q): expected invalid accent color, got %q, %v", tt.in, got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeAccentColor(%q): unexpected error: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("normalizeAccentColor(%q): expected %q, got %q", tt.in, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateBannerURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		wantErr bool
+	}{
+		{name: "https", in: "https://cdn.example.com/banners/1.jpg"},
+		{name: "http with port", in: "http://localhost:8002/banner.png"},
+		{name: "empty", in: "", wantErr: true},
+		{name: "relative", in: "/banners/1.jpg", wantErr: true},
+		{name: "no host", in: "https:///banner.jpg", wantErr: true},
+		{name: "javascript", in: "javascript:alert(1)", wantErr: true},
+		{name: "data", in: "data:image/png;base64,AAAA", wantErr: true},
+		{name: "ftp", in: "ftp://example.com/banner.jpg", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBannerURL(tt.in)
+			if tt.wantErr && !errors.Is(err, errx.ErrorBannerURLIsNotValid) {
+				t.Fatalf("validateBannerURL(%q): expected invalid banner url, got %v", tt.in, err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("validateBannerURL(%q): unexpected error: %v", tt.in, err)
+			}
+		})
+	}
+}
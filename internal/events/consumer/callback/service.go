@@ -3,9 +3,11 @@ package callback
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/segmentio/kafka-go"
 	"github.com/umisto/kafkakit/box"
 	"github.com/umisto/logium"
+	"github.com/umisto/profiles-svc/internal/tracing"
 )
 
 type Inbox interface {
@@ -16,14 +18,33 @@ type Inbox interface {
 	) (box.InboxEvent, error)
 }
 
+type Traces interface {
+	SetInboxEventTraceContext(ctx context.Context, id uuid.UUID, traceContext map[string]string) error
+}
+
 type Service struct {
-	inbox Inbox
-	log   logium.Logger
+	inbox  Inbox
+	traces Traces
+	log    logium.Logger
 }
 
-func NewService(log logium.Logger, inbox Inbox) *Service {
+func NewService(log logium.Logger, inbox Inbox, traces Traces) *Service {
 	return &Service{
-		inbox: inbox,
-		log:   log,
+		inbox:  inbox,
+		traces: traces,
+		log:    log,
+	}
+}
+
+// saveTraceContext keeps the trace context of the message next to the inbox event, so the
+// inbox worker can continue the trace. Tracing is best effort and never fails the message.
+func (s Service) saveTraceContext(ctx context.Context, ev box.InboxEvent) {
+	traceContext := tracing.Inject(ctx)
+	if len(traceContext) == 0 {
+		return
+	}
+
+	if err := s.traces.SetInboxEventTraceContext(ctx, ev.ID, traceContext); err != nil {
+		s.log.Warnf("failed to save trace context of inbox event %s: %v", ev.ID, err)
 	}
 }
@@ -0,0 +1,21 @@
+package callback
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/umisto/kafkakit/box"
+)
+
+func (s Service) UpdateStatus(ctx context.Context, event kafka.Message) error {
+	ev, err := s.inbox.CreateInboxEvent(ctx, box.InboxStatusPending, event)
+	if err != nil {
+		s.log.Errorf("failed to upsert inbox event for account %s: %v", string(event.Key), err)
+		return fmt.Errorf("failed to processing account status change event for account %s: %w", string(event.Key), err)
+	}
+
+	s.saveTraceContext(ctx, ev)
+
+	return nil
+}
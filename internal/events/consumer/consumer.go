@@ -7,6 +7,10 @@ import (
 	"github.com/umisto/kafkakit/subscriber"
 	"github.com/umisto/logium"
 	"github.com/umisto/profiles-svc/internal/events/contracts"
+	"github.com/umisto/profiles-svc/internal/metrics"
+	"github.com/umisto/profiles-svc/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Service struct {
@@ -18,6 +22,8 @@ type Service struct {
 type callbacks interface {
 	CreateAccount(ctx context.Context, event kafka.Message) error
 	UpdateUsername(ctx context.Context, event kafka.Message) error
+	UpdateStatus(ctx context.Context, event kafka.Message) error
+	DeleteAccount(ctx context.Context, event kafka.Message) error
 }
 
 func New(log logium.Logger, addr []string, callbacks callbacks) *Service {
@@ -35,22 +41,52 @@ func (s Service) Run(ctx context.Context) {
 
 	go func() {
 		err := sub.Consume(ctx, func(m kafka.Message) (subscriber.HandlerFunc, bool) {
+			metrics.ObserveKafkaLag(m.Topic, m.Partition, m.HighWaterMark-m.Offset-1)
+
 			et, ok := subscriber.Header(m, "event_type")
 			if !ok {
 				return nil, false
 			}
 
+			var handler subscriber.HandlerFunc
 			switch et {
 			case contracts.AccountCreatedEvent:
-				return s.callbacks.CreateAccount, true
+				handler = s.callbacks.CreateAccount
 			case contracts.AccountUsernameChangeEvent:
-				return s.callbacks.UpdateUsername, true
+				handler = s.callbacks.UpdateUsername
+			case contracts.AccountStatusChangeEvent:
+				handler = s.callbacks.UpdateStatus
+			case contracts.AccountDeletedEvent:
+				handler = s.callbacks.DeleteAccount
 			default:
 				return nil, false
 			}
+
+			return traced(et, handler), true
 		})
 		if err != nil {
 			s.log.Warnf("accounts consumer stopped: %v", err)
 		}
 	}()
 }
+
+// traced runs the handler in a span continuing the trace from the message headers.
+func traced(eventType string, handler subscriber.HandlerFunc) subscriber.HandlerFunc {
+	return func(ctx context.Context, m kafka.Message) error {
+		ctx, span := tracing.StartKind(
+			tracing.ExtractKafka(ctx, m),
+			"kafka.consume "+eventType,
+			trace.SpanKindConsumer,
+			attribute.String("messaging.system", "kafka"),
+			attribute.String("messaging.destination.name", m.Topic),
+			attribute.Int("messaging.kafka.partition", m.Partition),
+			attribute.Int64("messaging.kafka.offset", m.Offset),
+		)
+		defer span.End()
+
+		err := handler(ctx, m)
+		tracing.RecordError(span, err)
+
+		return err
+	}
+}
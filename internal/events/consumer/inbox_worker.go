@@ -3,19 +3,32 @@ package consumer
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand/v2"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/umisto/kafkakit/box"
 	"github.com/umisto/logium"
 	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
 	"github.com/umisto/profiles-svc/internal/events/contracts"
+	"github.com/umisto/profiles-svc/internal/metrics"
+	"github.com/umisto/profiles-svc/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type InboxWorker struct {
-	log    logium.Logger
-	inbox  inbox
-	domain domain
+	log        logium.Logger
+	inbox      inbox
+	queue      queue
+	domain     domain
+	deadLetter deadLetter
+	cfg        InboxWorkerConfig
 }
 
 type inbox interface {
@@ -24,11 +37,6 @@ type inbox interface {
 		id uuid.UUID,
 	) (box.InboxEvent, error)
 
-	GetPendingInboxEvents(
-		ctx context.Context,
-		limit int32,
-	) ([]box.InboxEvent, error)
-
 	MarkInboxEventsAsProcessed(
 		ctx context.Context,
 		ids []uuid.UUID,
@@ -46,107 +54,364 @@ type inbox interface {
 	) ([]box.InboxEvent, error)
 }
 
+// queue hands out due inbox events, it must not return two unfinished events of the same key
+// at once and must hide claimed events from other workers until their lease expires.
+type queue interface {
+	ClaimInboxEvents(
+		ctx context.Context,
+		limit int32,
+		lease time.Duration,
+	) ([]entity.ClaimedInboxEvent, error)
+
+	CountPendingInboxEvents(ctx context.Context) (uint64, error)
+	GetOldestPendingInboxEvent(ctx context.Context) (box.InboxEvent, error)
+}
+
 type domain interface {
-	CreateProfile(ctx context.Context, userID uuid.UUID, username string) (entity.Profile, error)
-	UpdateProfileUsername(ctx context.Context, accountID uuid.UUID, username string) (entity.Profile, error)
+	CreateProfile(ctx context.Context, userID uuid.UUID, username string, usernameUpdatedAt time.Time, hidden bool) (entity.Profile, error)
+	UpdateProfileUsername(ctx context.Context, accountID uuid.UUID, username string, usernameUpdatedAt time.Time) (entity.Profile, error)
+	UpdateProfileHidden(ctx context.Context, accountID uuid.UUID, hidden bool) (entity.Profile, error)
+	DeleteProfile(ctx context.Context, accountID uuid.UUID) error
+}
+
+type deadLetter interface {
+	WriteInboxDeadLetter(ctx context.Context, payload contracts.InboxDeadLetterPayload) error
+}
+
+// InboxWorkerConfig controls concurrency and retries of inbox events, zero values fall back to defaults.
+type InboxWorkerConfig struct {
+	// Workers is the number of goroutines processing a batch, events are sharded between them by key.
+	Workers   int
+	BatchSize int32
+	// Lease is how long a claimed event stays hidden from other workers before it is claimed again.
+	Lease time.Duration
+
+	MaxAttempts    int32
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+
+	// DeadLetter republishes events moved to failed to contracts.AccountsDLQTopicV1.
+	DeadLetter bool
 }
 
+const (
+	eventInboxDefaultWorkers        = 4
+	eventInboxDefaultBatchSize      = 100
+	eventInboxDefaultLease          = 5 * time.Minute
+	eventInboxDefaultMaxAttempts    = 10
+	eventInboxDefaultRetryBaseDelay = 5 * time.Second
+	eventInboxDefaultRetryMaxDelay  = 30 * time.Minute
+	eventInboxBacklogInterval       = 15 * time.Second
+)
+
 func NewInboxWorker(
 	log logium.Logger,
 	inbox inbox,
+	queue queue,
 	domain domain,
+	deadLetter deadLetter,
+	cfg InboxWorkerConfig,
 ) InboxWorker {
+	if cfg.Workers <= 0 {
+		cfg.Workers = eventInboxDefaultWorkers
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = eventInboxDefaultBatchSize
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = eventInboxDefaultLease
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = eventInboxDefaultMaxAttempts
+	}
+	if cfg.RetryBaseDelay <= 0 {
+		cfg.RetryBaseDelay = eventInboxDefaultRetryBaseDelay
+	}
+	if cfg.RetryMaxDelay <= 0 {
+		cfg.RetryMaxDelay = eventInboxDefaultRetryMaxDelay
+	}
+
 	return InboxWorker{
-		log:    log,
-		inbox:  inbox,
-		domain: domain,
+		log:        log,
+		inbox:      inbox,
+		queue:      queue,
+		domain:     domain,
+		deadLetter: deadLetter,
+		cfg:        cfg,
 	}
 }
 
-const eventInboxRetryDelay = 1 * time.Minute
+// errPoisonEvent marks events that can never be processed, e.g. with a malformed key or payload,
+// they are failed right away instead of being retried.
+var errPoisonEvent = errors.New("poison event")
 
 func (w InboxWorker) Run(ctx context.Context) {
 	ticker := time.NewTicker(500 * time.Millisecond)
 	defer ticker.Stop()
 
+	backlog := time.NewTicker(eventInboxBacklogInterval)
+	defer backlog.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-backlog.C:
+			w.observeBacklog(ctx)
+			continue
 		case <-ticker.C:
 		}
 
-		events, err := w.inbox.GetPendingInboxEvents(ctx, 10)
-		if err != nil {
-			w.log.Errorf("failed to get pending inbox events, cause: %v", err)
-			continue
+		// drain the backlog without waiting for the ticker while batches come back full
+		for ctx.Err() == nil {
+			n, err := w.processBatch(ctx)
+			if err != nil {
+				w.log.Errorf("failed to claim inbox events, cause: %v", err)
+				break
+			}
+			if n < int(w.cfg.BatchSize) {
+				break
+			}
 		}
-		if len(events) == 0 {
+	}
+}
+
+// processBatch claims a batch of events and processes it in parallel, events with the same key
+// always land in the same shard and are processed in order.
+func (w InboxWorker) processBatch(ctx context.Context) (int, error) {
+	events, err := w.queue.ClaimInboxEvents(ctx, w.cfg.BatchSize, w.cfg.Lease)
+	if err != nil {
+		return 0, err
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	shards := make([][]entity.ClaimedInboxEvent, w.cfg.Workers)
+	for _, ev := range events {
+		i := shardOf(ev.Key, w.cfg.Workers)
+		shards[i] = append(shards[i], ev)
+	}
+
+	var (
+		mu        sync.Mutex
+		processed []uuid.UUID
+		wg        sync.WaitGroup
+	)
+
+	for _, shard := range shards {
+		if len(shard) == 0 {
 			continue
 		}
 
-		var processed []uuid.UUID
-		var delayed []uuid.UUID
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
 
-		for _, ev := range events {
-			w.log.Infof("processing inbox event: %s, type %s", ev.ID, ev.Type)
+			ids := w.processShard(ctx, shard)
 
-			key, err := uuid.Parse(ev.Key)
-			if err != nil {
-				w.log.Errorf("bad inbox event key, id: %s, key: %s, error: %v", ev.ID, ev.Key, err)
-				processed = append(processed, ev.ID)
-				continue
-			}
+			mu.Lock()
+			processed = append(processed, ids...)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
 
-			switch ev.Type {
-			case contracts.AccountCreatedEvent:
-				var p contracts.AccountCreatedPayload
-				if err = json.Unmarshal(ev.Payload, &p); err != nil {
-					w.log.Errorf("bad payload for %s, id: %s, error: %v", ev.Type, ev.ID, err)
-					processed = append(processed, ev.ID)
-					continue
-				}
-
-				if _, err = w.domain.CreateProfile(ctx, key, p.Account.Username); err != nil {
-					w.log.Errorf("failed to create profile, id: %s, error: %v", ev.ID, err)
-					delayed = append(delayed, ev.ID)
-					continue
-				}
-				processed = append(processed, ev.ID)
-
-			case contracts.AccountUsernameChangeEvent:
-				var p contracts.AccountUsernameChangePayload
-				if err = json.Unmarshal(ev.Payload, &p); err != nil {
-					w.log.Errorf("bad payload for %s, id: %s, error: %v", ev.Type, ev.ID, err)
-					processed = append(processed, ev.ID)
-					continue
-				}
-
-				if _, err = w.domain.UpdateProfileUsername(ctx, key, p.Account.Username); err != nil {
-					w.log.Errorf("failed to update profile username, id: %s, error: %v", ev.ID, err)
-					delayed = append(delayed, ev.ID)
-					continue
-				}
-				processed = append(processed, ev.ID)
-
-			default:
-				w.log.Warnf("unknown inbox event type: %s, id: %s", ev.Type, ev.ID)
-				processed = append(processed, ev.ID)
-			}
+	if len(processed) > 0 {
+		_, err = w.inbox.MarkInboxEventsAsProcessed(ctx, processed)
+		if err != nil {
+			w.log.Errorf("failed to mark inbox events as processed, ids: %v, error: %v", processed, err)
 		}
+	}
 
-		if len(processed) > 0 {
-			_, err = w.inbox.MarkInboxEventsAsProcessed(ctx, processed)
-			if err != nil {
-				w.log.Errorf("failed to mark inbox events as processed, ids: %v, error: %v", processed, err)
+	return len(events), nil
+}
+
+// processShard processes events in order and returns ids of the processed ones. The queue hands
+// out at most one event per key, a later event of a key is claimed only after this one is done.
+func (w InboxWorker) processShard(ctx context.Context, events []entity.ClaimedInboxEvent) []uuid.UUID {
+	var processed []uuid.UUID
+
+	for _, ev := range events {
+		// earlier leases of the event expired without a result, e.g. because it crashed the worker
+		if ev.Attempts >= w.cfg.MaxAttempts {
+			err := fmt.Errorf("%d attempts ended without a result", ev.Attempts)
+			w.log.Errorf("giving up on inbox event, id: %s, type: %s, error: %v", ev.ID, ev.Type, err)
+			w.fail(ctx, ev.InboxEvent, err)
+			continue
+		}
+
+		w.log.Infof("processing inbox event: %s, type %s", ev.ID, ev.Type)
+
+		err := w.process(ctx, ev)
+		switch {
+		case err == nil:
+			metrics.InboxEvents.WithLabelValues(ev.Type, metrics.InboxResultProcessed).Inc()
+			processed = append(processed, ev.ID)
+		case errors.Is(err, errPoisonEvent) || ev.Attempts+1 >= w.cfg.MaxAttempts:
+			w.log.Errorf("giving up on inbox event, id: %s, type: %s, attempts: %d, error: %v", ev.ID, ev.Type, ev.Attempts+1, err)
+			w.fail(ctx, ev.InboxEvent, err)
+		default:
+			delay := w.retryDelay(ev.Attempts)
+			metrics.InboxEvents.WithLabelValues(ev.Type, metrics.InboxResultDelayed).Inc()
+			w.log.Warnf("failed to process inbox event, id: %s, type: %s, retry in %s, error: %v", ev.ID, ev.Type, delay, err)
+			if _, err = w.inbox.MarkInboxEventsAsPending(ctx, []uuid.UUID{ev.ID}, delay); err != nil {
+				w.log.Errorf("failed to delay inbox event, id: %s, error: %v", ev.ID, err)
 			}
 		}
+	}
 
-		if len(delayed) > 0 {
-			_, err = w.inbox.MarkInboxEventsAsPending(ctx, delayed, eventInboxRetryDelay)
-			if err != nil {
-				w.log.Errorf("failed to delay inbox events, ids: %v, error: %v", delayed, err)
+	return processed
+}
+
+func (w InboxWorker) observeBacklog(ctx context.Context) {
+	count, err := w.queue.CountPendingInboxEvents(ctx)
+	if err != nil {
+		w.log.Errorf("failed to count pending inbox events, cause: %v", err)
+		return
+	}
+
+	oldest, err := w.queue.GetOldestPendingInboxEvent(ctx)
+	if err != nil {
+		w.log.Errorf("failed to get oldest pending inbox event, cause: %v", err)
+		return
+	}
+
+	var age time.Duration
+	if !oldest.CreatedAt.IsZero() {
+		age = time.Since(oldest.CreatedAt)
+	}
+
+	metrics.InboxBacklog.Set(float64(count))
+	metrics.InboxOldestPendingAge.Set(age.Seconds())
+}
+
+// process handles the event in a span continuing the trace of the message it was received from.
+func (w InboxWorker) process(ctx context.Context, ev entity.ClaimedInboxEvent) error {
+	ctx, span := tracing.StartKind(
+		tracing.Extract(ctx, ev.TraceContext),
+		"inbox.process "+ev.Type,
+		trace.SpanKindConsumer,
+		attribute.String("inbox.event_id", ev.ID.String()),
+		attribute.String("inbox.event_type", ev.Type),
+		attribute.Int("inbox.attempt", int(ev.Attempts)+1),
+	)
+	defer span.End()
+
+	err := w.handle(ctx, ev.InboxEvent)
+	tracing.RecordError(span, err)
+
+	return err
+}
+
+func shardOf(key string, shards int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return int(h.Sum32() % uint32(shards))
+}
+
+func (w InboxWorker) handle(ctx context.Context, ev box.InboxEvent) error {
+	key, err := uuid.Parse(ev.Key)
+	if err != nil {
+		return fmt.Errorf("%w: bad key '%s': %v", errPoisonEvent, ev.Key, err)
+	}
+
+	switch ev.Type {
+	case contracts.AccountCreatedEvent:
+		var p contracts.AccountCreatedPayload
+		if err = json.Unmarshal(ev.Payload, &p); err != nil {
+			return fmt.Errorf("%w: bad payload: %v", errPoisonEvent, err)
+		}
+
+		hidden := p.Account.Status != contracts.AccountStatusActive
+		if _, err = w.domain.CreateProfile(ctx, key, p.Account.Username, p.Account.UsernameUpdatedAt, hidden); err != nil {
+			return fmt.Errorf("creating profile: %w", err)
+		}
+
+	case contracts.AccountUsernameChangeEvent:
+		var p contracts.AccountUsernameChangePayload
+		if err = json.Unmarshal(ev.Payload, &p); err != nil {
+			return fmt.Errorf("%w: bad payload: %v", errPoisonEvent, err)
+		}
+
+		_, err = w.domain.UpdateProfileUsername(ctx, key, p.Account.Username, p.Account.UsernameUpdatedAt)
+		if errors.Is(err, errx.ErrorUsernameChangeOutdated) {
+			w.log.Warnf("discarding outdated username change, id: %s, error: %v", ev.ID, err)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("updating profile username: %w", err)
+		}
+
+	case contracts.AccountStatusChangeEvent:
+		var p contracts.AccountStatusChangePayload
+		if err = json.Unmarshal(ev.Payload, &p); err != nil {
+			return fmt.Errorf("%w: bad payload: %v", errPoisonEvent, err)
+		}
+
+		hidden := p.Account.Status != contracts.AccountStatusActive
+		if _, err = w.domain.UpdateProfileHidden(ctx, key, hidden); err != nil {
+			return fmt.Errorf("updating profile visibility: %w", err)
+		}
+
+	case contracts.AccountDeletedEvent:
+		if err = w.domain.DeleteProfile(ctx, key); err != nil {
+			return fmt.Errorf("deleting profile: %w", err)
+		}
+
+	default:
+		w.log.Warnf("unknown inbox event type: %s, id: %s", ev.Type, ev.ID)
+	}
+
+	return nil
+}
+
+// fail moves the event to failed and, if enabled, republishes it to the dead letter topic.
+func (w InboxWorker) fail(ctx context.Context, ev box.InboxEvent, cause error) {
+	if w.cfg.DeadLetter {
+		payload := json.RawMessage(ev.Payload)
+		if !json.Valid(payload) {
+			payload, _ = json.Marshal(string(ev.Payload))
+		}
+
+		err := w.deadLetter.WriteInboxDeadLetter(ctx, contracts.InboxDeadLetterPayload{
+			EventID:  ev.ID,
+			Topic:    ev.Topic,
+			Key:      ev.Key,
+			Type:     ev.Type,
+			Version:  ev.Version,
+			Producer: ev.Producer,
+			Payload:  payload,
+			Attempts: ev.Attempts + 1,
+			Error:    cause.Error(),
+			FailedAt: time.Now().UTC(),
+		})
+		if err != nil {
+			// keep the event pending, so it is not lost without a trace
+			w.log.Errorf("failed to write inbox event to dead letter topic, id: %s, error: %v", ev.ID, err)
+			if _, err = w.inbox.MarkInboxEventsAsPending(ctx, []uuid.UUID{ev.ID}, w.cfg.RetryMaxDelay); err != nil {
+				w.log.Errorf("failed to delay inbox event, id: %s, error: %v", ev.ID, err)
 			}
+			return
 		}
 	}
+
+	if _, err := w.inbox.MarkInboxEventsAsFailed(ctx, []uuid.UUID{ev.ID}); err != nil {
+		w.log.Errorf("failed to mark inbox event as failed, id: %s, error: %v", ev.ID, err)
+		return
+	}
+	metrics.InboxEvents.WithLabelValues(ev.Type, metrics.InboxResultFailed).Inc()
+}
+
+// retryDelay doubles the base delay for every previous attempt up to the max delay, then picks
+// a random point in its upper half, so events failed together do not retry in lockstep.
+func (w InboxWorker) retryDelay(attempts int32) time.Duration {
+	delay := w.cfg.RetryBaseDelay
+	for i := int32(0); i < attempts && delay < w.cfg.RetryMaxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, w.cfg.RetryMaxDelay)
+
+	half := delay / 2
+	return half + rand.N(half+1)
 }
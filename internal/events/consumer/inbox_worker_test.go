@@ -0,0 +1,331 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/kafkakit/box"
+	"github.com/umisto/logium"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/events/contracts"
+)
+
+// fakeInbox records the status every event is moved to.
+type fakeInbox struct {
+	mu        sync.Mutex
+	processed []uuid.UUID
+	failed    []uuid.UUID
+	delays    map[uuid.UUID]time.Duration
+}
+
+func newFakeInbox() *fakeInbox {
+	return &fakeInbox{delays: map[uuid.UUID]time.Duration{}}
+}
+
+func (f *fakeInbox) GetInboxEventByID(_ context.Context, _ uuid.UUID) (box.InboxEvent, error) {
+	return box.InboxEvent{}, nil
+}
+
+func (f *fakeInbox) MarkInboxEventsAsProcessed(_ context.Context, ids []uuid.UUID) ([]box.InboxEvent, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.processed = append(f.processed, ids...)
+	return nil, nil
+}
+
+func (f *fakeInbox) MarkInboxEventsAsFailed(_ context.Context, ids []uuid.UUID) ([]box.InboxEvent, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.failed = append(f.failed, ids...)
+	return nil, nil
+}
+
+func (f *fakeInbox) MarkInboxEventsAsPending(_ context.Context, ids []uuid.UUID, delay time.Duration) ([]box.InboxEvent, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, id := range ids {
+		f.delays[id] = delay
+	}
+	return nil, nil
+}
+
+// fakeQueue hands out the events once.
+type fakeQueue struct {
+	events []entity.ClaimedInboxEvent
+}
+
+func (f *fakeQueue) ClaimInboxEvents(_ context.Context, limit int32, _ time.Duration) ([]entity.ClaimedInboxEvent, error) {
+	n := min(int(limit), len(f.events))
+	claimed := f.events[:n]
+	f.events = f.events[n:]
+
+	return claimed, nil
+}
+
+func (f *fakeQueue) CountPendingInboxEvents(_ context.Context) (uint64, error) {
+	return uint64(len(f.events)), nil
+}
+
+func (f *fakeQueue) GetOldestPendingInboxEvent(_ context.Context) (box.InboxEvent, error) {
+	return box.InboxEvent{}, nil
+}
+
+// fakeDomain records deleted accounts and fails every call with err.
+type fakeDomain struct {
+	mu      sync.Mutex
+	deleted []uuid.UUID
+	err     error
+}
+
+func (f *fakeDomain) CreateProfile(_ context.Context, _ uuid.UUID, _ string, _ time.Time, _ bool) (entity.Profile, error) {
+	return entity.Profile{}, f.err
+}
+
+func (f *fakeDomain) UpdateProfileUsername(_ context.Context, _ uuid.UUID, _ string, _ time.Time) (entity.Profile, error) {
+	return entity.Profile{}, f.err
+}
+
+func (f *fakeDomain) UpdateProfileHidden(_ context.Context, _ uuid.UUID, _ bool) (entity.Profile, error) {
+	return entity.Profile{}, f.err
+}
+
+func (f *fakeDomain) DeleteProfile(_ context.Context, accountID uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.deleted = append(f.deleted, accountID)
+	return f.err
+}
+
+type fakeDeadLetter struct {
+	written []contracts.InboxDeadLetterPayload
+	err     error
+}
+
+func (f *fakeDeadLetter) WriteInboxDeadLetter(_ context.Context, payload contracts.InboxDeadLetterPayload) error {
+	if f.err != nil {
+		return f.err
+	}
+
+	f.written = append(f.written, payload)
+	return nil
+}
+
+func deletedEvent(key string, attempts int32) entity.ClaimedInboxEvent {
+	return entity.ClaimedInboxEvent{
+		InboxEvent: box.InboxEvent{
+			ID:       uuid.New(),
+			Topic:    "accounts.v1",
+			Key:      key,
+			Type:     contracts.AccountDeletedEvent,
+			Version:  1,
+			Producer: "accounts-svc",
+			Payload:  []byte(`{}`),
+			Status:   box.InboxStatusProcessing,
+			Attempts: attempts,
+		},
+	}
+}
+
+func newTestInboxWorker(inbox *fakeInbox, queue *fakeQueue, domain *fakeDomain, dlq *fakeDeadLetter) InboxWorker {
+	return NewInboxWorker(logium.NewLogger("debug", "text"), inbox, queue, domain, dlq, InboxWorkerConfig{
+		Workers:        4,
+		MaxAttempts:    3,
+		RetryBaseDelay: time.Second,
+		RetryMaxDelay:  time.Minute,
+		DeadLetter:     true,
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	w := newTestInboxWorker(newFakeInbox(), &fakeQueue{}, &fakeDomain{}, &fakeDeadLetter{})
+
+	tests := []struct {
+		attempts int32
+		max      time.Duration
+	}{
+		{attempts: 0, max: time.Second},
+		{attempts: 1, max: 2 * time.Second},
+		{attempts: 3, max: 8 * time.Second},
+		{attempts: 6, max: time.Minute},
+		{attempts: 100, max: time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d attempts", tt.attempts), func(t *testing.T) {
+			seen := map[time.Duration]bool{}
+			for i := 0; i < 200; i++ {
+				delay := w.retryDelay(tt.attempts)
+				if delay < tt.max/2 || delay > tt.max {
+					t.Fatalf("expected a delay within [%s, %s], got %s", tt.max/2, tt.max, delay)
+				}
+				seen[delay] = true
+			}
+			if len(seen) < 2 {
+				t.Fatalf("expected jittered delays, got %v only", seen)
+			}
+		})
+	}
+}
+
+func TestProcessShard(t *testing.T) {
+	errDown := errors.New("database is down")
+
+	tests := []struct {
+		name       string
+		event      entity.ClaimedInboxEvent
+		domainErr  error
+		dlqErr     error
+		wantStatus string
+		wantDelay  [2]time.Duration
+		wantDLQ    bool
+		wantCalled bool
+	}{
+		{
+			name:       "processed",
+			event:      deletedEvent(uuid.NewString(), 0),
+			wantStatus: box.InboxStatusProcessed,
+			wantCalled: true,
+		},
+		{
+			name:       "failure is retried after a jittered delay",
+			event:      deletedEvent(uuid.NewString(), 0),
+			domainErr:  errDown,
+			wantStatus: box.InboxStatusPending,
+			wantDelay:  [2]time.Duration{time.Second / 2, time.Second},
+			wantCalled: true,
+		},
+		{
+			name:       "failure of the last attempt is dead lettered",
+			event:      deletedEvent(uuid.NewString(), 2),
+			domainErr:  errDown,
+			wantStatus: box.InboxStatusFailed,
+			wantDLQ:    true,
+			wantCalled: true,
+		},
+		{
+			name:       "poison event is dead lettered at once",
+			event:      deletedEvent("not-a-uuid", 0),
+			wantStatus: box.InboxStatusFailed,
+			wantDLQ:    true,
+		},
+		{
+			name:       "event stays pending when the dead letter write fails",
+			event:      deletedEvent(uuid.NewString(), 2),
+			domainErr:  errDown,
+			dlqErr:     errors.New("broker unavailable"),
+			wantStatus: box.InboxStatusPending,
+			wantDelay:  [2]time.Duration{time.Minute, time.Minute},
+			wantCalled: true,
+		},
+		{
+			name:       "expired leases used up the attempts",
+			event:      deletedEvent(uuid.NewString(), 3),
+			wantStatus: box.InboxStatusFailed,
+			wantDLQ:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inbox := newFakeInbox()
+			domain := &fakeDomain{err: tt.domainErr}
+			dlq := &fakeDeadLetter{err: tt.dlqErr}
+			w := newTestInboxWorker(inbox, &fakeQueue{}, domain, dlq)
+
+			processed := w.processShard(context.Background(), []entity.ClaimedInboxEvent{tt.event})
+
+			status := ""
+			delay, delayed := inbox.delays[tt.event.ID]
+			switch {
+			case len(processed) == 1:
+				status = box.InboxStatusProcessed
+			case len(inbox.failed) == 1:
+				status = box.InboxStatusFailed
+			case delayed:
+				status = box.InboxStatusPending
+			}
+
+			if status != tt.wantStatus {
+				t.Fatalf("expected status %q, got %q", tt.wantStatus, status)
+			}
+			if delayed && (delay < tt.wantDelay[0] || delay > tt.wantDelay[1]) {
+				t.Fatalf("expected a retry delay within [%s, %s], got %s", tt.wantDelay[0], tt.wantDelay[1], delay)
+			}
+			if called := len(domain.deleted) > 0; called != tt.wantCalled {
+				t.Fatalf("expected the domain to be called: %t, got %t", tt.wantCalled, called)
+			}
+			if tt.wantDLQ != (len(dlq.written) == 1) {
+				t.Fatalf("expected a dead letter: %t, got %d", tt.wantDLQ, len(dlq.written))
+			}
+			if tt.wantDLQ {
+				dl := dlq.written[0]
+				if dl.EventID != tt.event.ID || dl.Key != tt.event.Key || dl.Attempts != tt.event.Attempts+1 || dl.Error == "" {
+					t.Fatalf("unexpected dead letter %+v", dl)
+				}
+			}
+		})
+	}
+}
+
+func TestShardOf(t *testing.T) {
+	const shards = 4
+
+	used := map[int]bool{}
+	for i := 0; i < 100; i++ {
+		key := uuid.NewString()
+
+		shard := shardOf(key, shards)
+		if shard < 0 || shard >= shards {
+			t.Fatalf("shardOf(%q): expected a shard below %d, got %d", key, shards, shard)
+		}
+		if again := shardOf(key, shards); again != shard {
+			t.Fatalf("shardOf(%q): expected the same shard, got %d and %d", key, shard, again)
+		}
+		used[shard] = true
+	}
+
+	if len(used) != shards {
+		t.Fatalf("expected keys to spread over %d shards, got %d", shards, len(used))
+	}
+}
+
+func TestProcessBatch(t *testing.T) {
+	var events []entity.ClaimedInboxEvent
+	for i := 0; i < 20; i++ {
+		events = append(events, deletedEvent(uuid.NewString(), 0))
+	}
+
+	inbox := newFakeInbox()
+	domain := &fakeDomain{}
+	w := newTestInboxWorker(inbox, &fakeQueue{events: events}, domain, &fakeDeadLetter{})
+
+	n, err := w.processBatch(context.Background())
+	if err != nil {
+		t.Fatalf("processBatch: %v", err)
+	}
+	if n != len(events) {
+		t.Fatalf("expected %d claimed events, got %d", len(events), n)
+	}
+	if len(inbox.processed) != len(events) || len(domain.deleted) != len(events) {
+		t.Fatalf("expected %d processed events, got %d marked and %d handled", len(events), len(inbox.processed), len(domain.deleted))
+	}
+
+	marked := map[uuid.UUID]bool{}
+	for _, id := range inbox.processed {
+		marked[id] = true
+	}
+	for _, ev := range events {
+		if !marked[ev.ID] {
+			t.Fatalf("expected event %s to be marked as processed", ev.ID)
+		}
+	}
+}
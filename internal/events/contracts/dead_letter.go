@@ -0,0 +1,25 @@
+package contracts
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const InboxDeadLetterEvent = "inbox.dead_letter"
+
+// InboxDeadLetterPayload wraps an inbox event that could not be processed together with the last error.
+type InboxDeadLetterPayload struct {
+	EventID  uuid.UUID       `json:"event_id"`
+	Topic    string          `json:"topic"`
+	Key      string          `json:"key"`
+	Type     string          `json:"type"`
+	Version  int32           `json:"version"`
+	Producer string          `json:"producer"`
+	Payload  json.RawMessage `json:"payload"`
+
+	Attempts int32     `json:"attempts"`
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failed_at"`
+}
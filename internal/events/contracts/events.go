@@ -37,3 +37,38 @@ type AccountUsernameChangePayload struct {
 	} `json:"account"`
 	Email string `json:"email,omitempty"`
 }
+
+const AccountDeletedEvent = "account.deleted"
+
+type AccountDeletedPayload struct {
+	Account struct {
+		ID       uuid.UUID `json:"id"`
+		Username string    `json:"username"`
+		Role     string    `json:"role"`
+		Status   string    `json:"status"`
+
+		CreatedAt         time.Time `json:"created_at"`
+		UpdatedAt         time.Time `json:"updated_at"`
+		UsernameUpdatedAt time.Time `json:"username_name_updated_at"`
+	} `json:"account"`
+	Email string `json:"email,omitempty"`
+}
+
+const AccountStatusChangeEvent = "account.status.change"
+
+type AccountStatusChangePayload struct {
+	Account struct {
+		ID       uuid.UUID `json:"id"`
+		Username string    `json:"username"`
+		Role     string    `json:"role"`
+		Status   string    `json:"status"`
+
+		CreatedAt         time.Time `json:"created_at"`
+		UpdatedAt         time.Time `json:"updated_at"`
+		UsernameUpdatedAt time.Time `json:"username_name_updated_at"`
+	} `json:"account"`
+	Email string `json:"email,omitempty"`
+}
+
+// AccountStatusActive is the only account status for which the profile stays publicly visible.
+const AccountStatusActive = "active"
@@ -0,0 +1,32 @@
+package contracts
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	OutboxStatusPending    = "pending"
+	OutboxStatusProcessing = "processing"
+	OutboxStatusSent       = "sent"
+	OutboxStatusFailed     = "failed"
+)
+
+type OutboxEvent struct {
+	ID       uuid.UUID       `json:"id"`
+	Topic    string          `json:"topic"`
+	Key      string          `json:"key"`
+	Type     string          `json:"type"`
+	Version  int32           `json:"version"`
+	Producer string          `json:"producer"`
+	Payload  json.RawMessage `json:"payload"`
+
+	Status   string `json:"status"`
+	Attempts int32  `json:"attempts"`
+
+	CreatedAt   time.Time  `json:"created_at"`
+	NextRetryAt *time.Time `json:"next_retry_at,omitempty"`
+	SentAt      *time.Time `json:"sent_at,omitempty"`
+}
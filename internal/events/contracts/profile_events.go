@@ -0,0 +1,32 @@
+package contracts
+
+import (
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+)
+
+const ProducerProfilesSvc = "profiles-svc"
+
+const ProfileCreatedEvent = "profile.created"
+
+type ProfileCreatedPayload struct {
+	Profile entity.Profile `json:"profile"`
+}
+
+const ProfileUpdatedEvent = "profile.updated"
+
+type ProfileUpdatedPayload struct {
+	Profile entity.Profile `json:"profile"`
+}
+
+const ProfileOfficialChangedEvent = "profile.official.changed"
+
+type ProfileOfficialChangedPayload struct {
+	Profile entity.Profile `json:"profile"`
+}
+
+const ProfileDeletedEvent = "profile.deleted"
+
+type ProfileDeletedPayload struct {
+	AccountID uuid.UUID `json:"account_id"`
+}
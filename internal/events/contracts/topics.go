@@ -3,3 +3,8 @@ package contracts
 const GroupProfilesSvc = "profiles-svc"
 
 const AccountsTopicV1 = "accounts.v1"
+
+// AccountsDLQTopicV1 receives account events the inbox gave up on.
+const AccountsDLQTopicV1 = "accounts.v1.dlq"
+
+const ProfilesTopicV1 = "profiles.v1"
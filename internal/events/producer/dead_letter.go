@@ -0,0 +1,17 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/umisto/profiles-svc/internal/events/contracts"
+)
+
+func (s Service) WriteInboxDeadLetter(ctx context.Context, payload contracts.InboxDeadLetterPayload) error {
+	return s.writeEvent(
+		ctx,
+		contracts.AccountsDLQTopicV1,
+		payload.Key,
+		contracts.InboxDeadLetterEvent,
+		payload,
+	)
+}
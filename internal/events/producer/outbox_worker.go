@@ -0,0 +1,180 @@
+package producer
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"github.com/umisto/logium"
+	"github.com/umisto/profiles-svc/internal/events/contracts"
+)
+
+type OutboxWorker struct {
+	log    logium.Logger
+	outbox outboxQueue
+	writer messageWriter
+}
+
+type messageWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+type outboxQueue interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	GetPendingOutboxEvents(
+		ctx context.Context,
+		limit int32,
+	) ([]contracts.OutboxEvent, error)
+
+	MarkOutboxEventsAsSent(
+		ctx context.Context,
+		ids []uuid.UUID,
+	) ([]contracts.OutboxEvent, error)
+
+	MarkOutboxEventsAsFailed(
+		ctx context.Context,
+		ids []uuid.UUID,
+	) ([]contracts.OutboxEvent, error)
+
+	MarkOutboxEventsAsPending(
+		ctx context.Context,
+		ids []uuid.UUID,
+		delay time.Duration,
+	) ([]contracts.OutboxEvent, error)
+}
+
+func NewOutboxWorker(
+	log logium.Logger,
+	addr []string,
+	outbox outboxQueue,
+) OutboxWorker {
+	return OutboxWorker{
+		log:    log,
+		outbox: outbox,
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(addr...),
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+		},
+	}
+}
+
+const (
+	eventOutboxBatchSize      = 10
+	eventOutboxMaxAttempts    = 10
+	eventOutboxRetryBaseDelay = 5 * time.Second
+	eventOutboxRetryMaxDelay  = 10 * time.Minute
+)
+
+func (w OutboxWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	defer func() {
+		if err := w.writer.Close(); err != nil {
+			w.log.Errorf("failed to close outbox kafka writer, cause: %v", err)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := w.outbox.Transaction(ctx, func(ctx context.Context) error {
+			return w.sendBatch(ctx)
+		})
+		if err != nil {
+			w.log.Errorf("failed to process outbox events, cause: %v", err)
+		}
+	}
+}
+
+// sendBatch publishes one batch of pending events. It is expected to run inside a transaction,
+// so the selected rows stay locked until their new status is committed.
+func (w OutboxWorker) sendBatch(ctx context.Context) error {
+	events, err := w.outbox.GetPendingOutboxEvents(ctx, eventOutboxBatchSize)
+	if err != nil {
+		return err
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	var sent []uuid.UUID
+	var failed []uuid.UUID
+
+	// the batch holds at most one event per key, later events of a key whose event is delayed
+	// or failed here are not selected until it is sent, so consumers never see them out of order
+	for _, ev := range events {
+		if err = w.writer.WriteMessages(ctx, outboxEventToMessage(ev)); err != nil {
+			w.log.Errorf("failed to publish outbox event, id: %s, type: %s, error: %v", ev.ID, ev.Type, err)
+
+			if ev.Attempts+1 >= eventOutboxMaxAttempts {
+				failed = append(failed, ev.ID)
+				continue
+			}
+
+			w.delay(ctx, ev)
+			continue
+		}
+
+		sent = append(sent, ev.ID)
+	}
+
+	if len(sent) > 0 {
+		if _, err = w.outbox.MarkOutboxEventsAsSent(ctx, sent); err != nil {
+			w.log.Errorf("failed to mark outbox events as sent, ids: %v, error: %v", sent, err)
+		}
+	}
+
+	if len(failed) > 0 {
+		if _, err = w.outbox.MarkOutboxEventsAsFailed(ctx, failed); err != nil {
+			w.log.Errorf("failed to mark outbox events as failed, ids: %v, error: %v", failed, err)
+		}
+	}
+
+	return nil
+}
+
+func (w OutboxWorker) delay(ctx context.Context, ev contracts.OutboxEvent) {
+	if _, err := w.outbox.MarkOutboxEventsAsPending(ctx, []uuid.UUID{ev.ID}, outboxRetryDelay(ev.Attempts)); err != nil {
+		w.log.Errorf("failed to delay outbox event, id: %s, error: %v", ev.ID, err)
+	}
+}
+
+// outboxRetryDelay doubles the base delay for every previous attempt, up to eventOutboxRetryMaxDelay.
+func outboxRetryDelay(attempts int32) time.Duration {
+	delay := eventOutboxRetryBaseDelay
+	for i := int32(0); i < attempts; i++ {
+		delay *= 2
+		if delay >= eventOutboxRetryMaxDelay {
+			return eventOutboxRetryMaxDelay
+		}
+	}
+
+	return delay
+}
+
+func outboxEventToMessage(ev contracts.OutboxEvent) kafka.Message {
+	return kafka.Message{
+		Topic: ev.Topic,
+		Key:   []byte(ev.Key),
+		Value: ev.Payload,
+		Headers: []kafka.Header{
+			{Key: "event_id", Value: []byte(ev.ID.String())},
+			{Key: "event_type", Value: []byte(ev.Type)},
+			{Key: "event_version", Value: []byte(strconv.Itoa(int(ev.Version)))},
+			{Key: "producer", Value: []byte(ev.Producer)},
+			{Key: "content_type", Value: []byte("application/json")},
+		},
+		Time: ev.CreatedAt,
+	}
+}
@@ -0,0 +1,304 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"github.com/umisto/logium"
+	"github.com/umisto/profiles-svc/internal/events/contracts"
+)
+
+// fakeOutbox keeps events in memory and selects them like the repository does: pending and due,
+// oldest first, only the oldest unsent event of every key.
+type fakeOutbox struct {
+	now    time.Time
+	events []*contracts.OutboxEvent
+	delays map[uuid.UUID]time.Duration
+}
+
+func newFakeOutbox(now time.Time) *fakeOutbox {
+	return &fakeOutbox{now: now, delays: map[uuid.UUID]time.Duration{}}
+}
+
+func (q *fakeOutbox) add(key string, attempts int32) *contracts.OutboxEvent {
+	ev := &contracts.OutboxEvent{
+		ID:        uuid.New(),
+		Topic:     "profiles.v1",
+		Key:       key,
+		Type:      "profile.updated",
+		Version:   1,
+		Producer:  "profiles-svc",
+		Payload:   []byte(`{}`),
+		Status:    contracts.OutboxStatusPending,
+		Attempts:  attempts,
+		CreatedAt: q.now.Add(time.Duration(len(q.events)) * time.Millisecond),
+	}
+	q.events = append(q.events, ev)
+
+	return ev
+}
+
+func (q *fakeOutbox) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (q *fakeOutbox) GetPendingOutboxEvents(_ context.Context, limit int32) ([]contracts.OutboxEvent, error) {
+	ordered := append([]*contracts.OutboxEvent(nil), q.events...)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].CreatedAt.Before(ordered[j].CreatedAt) })
+
+	var res []contracts.OutboxEvent
+	held := map[string]bool{}
+	for _, ev := range ordered {
+		if ev.Status == contracts.OutboxStatusSent {
+			continue
+		}
+		first := !held[ev.Key]
+		held[ev.Key] = true
+
+		due := ev.NextRetryAt == nil || !ev.NextRetryAt.After(q.now)
+		if first && ev.Status == contracts.OutboxStatusPending && due && len(res) < int(limit) {
+			res = append(res, *ev)
+		}
+	}
+
+	return res, nil
+}
+
+func (q *fakeOutbox) update(ids []uuid.UUID, fn func(ev *contracts.OutboxEvent)) []contracts.OutboxEvent {
+	var res []contracts.OutboxEvent
+	for _, id := range ids {
+		for _, ev := range q.events {
+			if ev.ID == id {
+				ev.Attempts++
+				fn(ev)
+				res = append(res, *ev)
+			}
+		}
+	}
+
+	return res
+}
+
+func (q *fakeOutbox) MarkOutboxEventsAsSent(_ context.Context, ids []uuid.UUID) ([]contracts.OutboxEvent, error) {
+	return q.update(ids, func(ev *contracts.OutboxEvent) {
+		ev.Status = contracts.OutboxStatusSent
+		ev.NextRetryAt = nil
+	}), nil
+}
+
+func (q *fakeOutbox) MarkOutboxEventsAsFailed(_ context.Context, ids []uuid.UUID) ([]contracts.OutboxEvent, error) {
+	return q.update(ids, func(ev *contracts.OutboxEvent) {
+		ev.Status = contracts.OutboxStatusFailed
+		ev.NextRetryAt = nil
+	}), nil
+}
+
+func (q *fakeOutbox) MarkOutboxEventsAsPending(_ context.Context, ids []uuid.UUID, delay time.Duration) ([]contracts.OutboxEvent, error) {
+	return q.update(ids, func(ev *contracts.OutboxEvent) {
+		next := q.now.Add(delay)
+		ev.Status = contracts.OutboxStatusPending
+		ev.NextRetryAt = &next
+		q.delays[ev.ID] = delay
+	}), nil
+}
+
+// fakeWriter records published messages and fails the writes of the event ids in fail.
+type fakeWriter struct {
+	written []kafka.Message
+	fail    map[string]bool
+}
+
+func (w *fakeWriter) WriteMessages(_ context.Context, msgs ...kafka.Message) error {
+	for _, msg := range msgs {
+		if w.fail[eventID(msg)] {
+			return errors.New("broker unavailable")
+		}
+		w.written = append(w.written, msg)
+	}
+
+	return nil
+}
+
+func (w *fakeWriter) Close() error {
+	return nil
+}
+
+func eventID(msg kafka.Message) string {
+	for _, h := range msg.Headers {
+		if h.Key == "event_id" {
+			return string(h.Value)
+		}
+	}
+
+	return ""
+}
+
+func newTestWorker(q *fakeOutbox, w *fakeWriter) OutboxWorker {
+	return OutboxWorker{
+		log:    logium.NewLogger("debug", "text"),
+		outbox: q,
+		writer: w,
+	}
+}
+
+func TestSendBatch(t *testing.T) {
+	q := newFakeOutbox(time.Now().UTC())
+	first := q.add("a", 0)
+	second := q.add("b", 0)
+	third := q.add("c", 0)
+
+	w := &fakeWriter{}
+	if err := newTestWorker(q, w).sendBatch(context.Background()); err != nil {
+		t.Fatalf("sendBatch: %v", err)
+	}
+
+	want := []*contracts.OutboxEvent{first, second, third}
+	if len(w.written) != len(want) {
+		t.Fatalf("expected %d messages, got %d", len(want), len(w.written))
+	}
+	for i, ev := range want {
+		msg := w.written[i]
+		if eventID(msg) != ev.ID.String() || string(msg.Key) != ev.Key || msg.Topic != ev.Topic {
+			t.Fatalf("message %d: expected event %s of key %s, got %s of key %s", i, ev.ID, ev.Key, eventID(msg), msg.Key)
+		}
+		if ev.Status != contracts.OutboxStatusSent {
+			t.Fatalf("event %d: expected status %s, got %s", i, contracts.OutboxStatusSent, ev.Status)
+		}
+	}
+}
+
+func TestSendBatchRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		attempts     int32
+		wantStatus   string
+		wantAttempts int32
+		wantDelay    time.Duration
+	}{
+		{
+			name:         "first failure is retried after the base delay",
+			attempts:     0,
+			wantStatus:   contracts.OutboxStatusPending,
+			wantAttempts: 1,
+			wantDelay:    eventOutboxRetryBaseDelay,
+		},
+		{
+			name:         "later failures back off",
+			attempts:     3,
+			wantStatus:   contracts.OutboxStatusPending,
+			wantAttempts: 4,
+			wantDelay:    8 * eventOutboxRetryBaseDelay,
+		},
+		{
+			name:         "last attempt fails the event",
+			attempts:     eventOutboxMaxAttempts - 1,
+			wantStatus:   contracts.OutboxStatusFailed,
+			wantAttempts: eventOutboxMaxAttempts,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newFakeOutbox(time.Now().UTC())
+			broken := q.add("a", tt.attempts)
+			other := q.add("b", 0)
+
+			w := &fakeWriter{fail: map[string]bool{broken.ID.String(): true}}
+			if err := newTestWorker(q, w).sendBatch(context.Background()); err != nil {
+				t.Fatalf("sendBatch: %v", err)
+			}
+
+			if broken.Status != tt.wantStatus {
+				t.Fatalf("expected status %s, got %s", tt.wantStatus, broken.Status)
+			}
+			if broken.Attempts != tt.wantAttempts {
+				t.Fatalf("expected %d attempts, got %d", tt.wantAttempts, broken.Attempts)
+			}
+			if delay := q.delays[broken.ID]; delay != tt.wantDelay {
+				t.Fatalf("expected retry delay %s, got %s", tt.wantDelay, delay)
+			}
+			if other.Status != contracts.OutboxStatusSent {
+				t.Fatalf("expected the event of another key to be sent, got %s", other.Status)
+			}
+		})
+	}
+}
+
+func TestSendBatchKeyOrder(t *testing.T) {
+	q := newFakeOutbox(time.Now().UTC())
+	first := q.add("a", 0)
+	second := q.add("a", 0)
+
+	w := &fakeWriter{fail: map[string]bool{first.ID.String(): true}}
+	worker := newTestWorker(q, w)
+
+	if err := worker.sendBatch(context.Background()); err != nil {
+		t.Fatalf("sendBatch: %v", err)
+	}
+	if len(w.written) != 0 {
+		t.Fatalf("expected nothing to be published while the first event waits, got %d messages", len(w.written))
+	}
+
+	// the retry of the first event is due, the broker is back
+	q.now = q.now.Add(eventOutboxRetryBaseDelay)
+	w.fail = nil
+
+	for i := 0; i < 2; i++ {
+		if err := worker.sendBatch(context.Background()); err != nil {
+			t.Fatalf("sendBatch: %v", err)
+		}
+	}
+
+	if len(w.written) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(w.written))
+	}
+	if eventID(w.written[0]) != first.ID.String() || eventID(w.written[1]) != second.ID.String() {
+		t.Fatalf("expected %s before %s, got %s before %s", first.ID, second.ID, eventID(w.written[0]), eventID(w.written[1]))
+	}
+}
+
+func TestSendBatchFailedHoldsKey(t *testing.T) {
+	q := newFakeOutbox(time.Now().UTC())
+	first := q.add("a", eventOutboxMaxAttempts-1)
+	second := q.add("a", 0)
+
+	w := &fakeWriter{fail: map[string]bool{first.ID.String(): true}}
+	worker := newTestWorker(q, w)
+
+	for i := 0; i < 3; i++ {
+		if err := worker.sendBatch(context.Background()); err != nil {
+			t.Fatalf("sendBatch: %v", err)
+		}
+	}
+
+	if first.Status != contracts.OutboxStatusFailed {
+		t.Fatalf("expected the first event to fail, got %s", first.Status)
+	}
+	if second.Status != contracts.OutboxStatusPending || len(w.written) != 0 {
+		t.Fatalf("expected the later event of the key to be held back, got %s and %d messages", second.Status, len(w.written))
+	}
+}
+
+func TestOutboxRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{attempts: 0, want: eventOutboxRetryBaseDelay},
+		{attempts: 1, want: 2 * eventOutboxRetryBaseDelay},
+		{attempts: 4, want: 16 * eventOutboxRetryBaseDelay},
+		{attempts: 7, want: eventOutboxRetryMaxDelay},
+		{attempts: 40, want: eventOutboxRetryMaxDelay},
+	}
+
+	for _, tt := range tests {
+		if got := outboxRetryDelay(tt.attempts); got != tt.want {
+			t.Fatalf("outboxRetryDelay(%d): expected %s, got %s", tt.attempts, tt.want, got)
+		}
+	}
+}
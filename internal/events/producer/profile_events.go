@@ -0,0 +1,49 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/events/contracts"
+)
+
+func (s Service) WriteProfileCreated(ctx context.Context, profile entity.Profile) error {
+	return s.writeEvent(
+		ctx,
+		contracts.ProfilesTopicV1,
+		profile.AccountID.String(),
+		contracts.ProfileCreatedEvent,
+		contracts.ProfileCreatedPayload{Profile: profile},
+	)
+}
+
+func (s Service) WriteProfileUpdated(ctx context.Context, profile entity.Profile) error {
+	return s.writeEvent(
+		ctx,
+		contracts.ProfilesTopicV1,
+		profile.AccountID.String(),
+		contracts.ProfileUpdatedEvent,
+		contracts.ProfileUpdatedPayload{Profile: profile},
+	)
+}
+
+func (s Service) WriteProfileOfficialChanged(ctx context.Context, profile entity.Profile) error {
+	return s.writeEvent(
+		ctx,
+		contracts.ProfilesTopicV1,
+		profile.AccountID.String(),
+		contracts.ProfileOfficialChangedEvent,
+		contracts.ProfileOfficialChangedPayload{Profile: profile},
+	)
+}
+
+func (s Service) WriteProfileDeleted(ctx context.Context, accountID uuid.UUID) error {
+	return s.writeEvent(
+		ctx,
+		contracts.ProfilesTopicV1,
+		accountID.String(),
+		contracts.ProfileDeletedEvent,
+		contracts.ProfileDeletedPayload{AccountID: accountID},
+	)
+}
@@ -0,0 +1,47 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/umisto/logium"
+	"github.com/umisto/profiles-svc/internal/events/contracts"
+)
+
+type Service struct {
+	log    logium.Logger
+	outbox outbox
+}
+
+type outbox interface {
+	CreateOutboxEvent(ctx context.Context, event contracts.OutboxEvent) (contracts.OutboxEvent, error)
+}
+
+func New(log logium.Logger, outbox outbox) Service {
+	return Service{
+		log:    log,
+		outbox: outbox,
+	}
+}
+
+func (s Service) writeEvent(ctx context.Context, topic, key, eventType string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal %s payload: %w", eventType, err)
+	}
+
+	_, err = s.outbox.CreateOutboxEvent(ctx, contracts.OutboxEvent{
+		Topic:    topic,
+		Key:      key,
+		Type:     eventType,
+		Version:  1,
+		Producer: contracts.ProducerProfilesSvc,
+		Payload:  data,
+	})
+	if err != nil {
+		return fmt.Errorf("store %s event in outbox: %w", eventType, err)
+	}
+
+	return nil
+}
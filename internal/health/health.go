@@ -0,0 +1,196 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/umisto/profiles-svc/cmd/migrations"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+const (
+	CheckPostgres   = "postgres"
+	CheckMigrations = "migrations"
+	CheckKafka      = "kafka"
+	CheckInbox      = "inbox"
+)
+
+const (
+	DefaultTimeout     = 2 * time.Second
+	DefaultInboxMaxAge = 5 * time.Minute
+)
+
+// Report is the readiness state of the service, it is up only when every check is up.
+type Report struct {
+	Status string           `json:"status"`
+	Checks map[string]Check `json:"checks"`
+}
+
+type Check struct {
+	Status  string `json:"status"`
+	Latency string `json:"latency"`
+	Error   string `json:"error,omitempty"`
+	Details any    `json:"details,omitempty"`
+}
+
+type Config struct {
+	// Timeout bounds every single check.
+	Timeout time.Duration
+	// InboxMaxAge is how long a due inbox event may wait to be claimed before the service is not ready.
+	// Events in retry backoff do not count, so a single failing event cannot take every replica out.
+	InboxMaxAge time.Duration
+}
+
+type Checker struct {
+	pg      *sql.DB
+	brokers []string
+	inbox   inbox
+	cfg     Config
+}
+
+type inbox interface {
+	GetOldestDueInboxEventTime(ctx context.Context) (time.Time, error)
+}
+
+func New(pg *sql.DB, brokers []string, inbox inbox, cfg Config) Checker {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.InboxMaxAge <= 0 {
+		cfg.InboxMaxAge = DefaultInboxMaxAge
+	}
+
+	return Checker{
+		pg:      pg,
+		brokers: brokers,
+		inbox:   inbox,
+		cfg:     cfg,
+	}
+}
+
+// Check runs all dependency checks concurrently.
+func (c Checker) Check(ctx context.Context) Report {
+	checks := map[string]func(ctx context.Context) (any, error){
+		CheckPostgres:   c.checkPostgres,
+		CheckMigrations: c.checkMigrations,
+		CheckKafka:      c.checkKafka,
+		CheckInbox:      c.checkInbox,
+	}
+
+	report := Report{
+		Status: StatusUp,
+		Checks: make(map[string]Check, len(checks)),
+	}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for name, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			res := c.run(ctx, check)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[name] = res
+			if res.Status != StatusUp {
+				report.Status = StatusDown
+			}
+		}()
+	}
+	wg.Wait()
+
+	return report
+}
+
+func (c Checker) run(ctx context.Context, check func(ctx context.Context) (any, error)) Check {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	details, err := check(ctx)
+
+	res := Check{
+		Status:  StatusUp,
+		Latency: time.Since(start).String(),
+		Details: details,
+	}
+	if err != nil {
+		res.Status = StatusDown
+		res.Error = err.Error()
+	}
+
+	return res
+}
+
+func (c Checker) checkPostgres(ctx context.Context) (any, error) {
+	return nil, c.pg.PingContext(ctx)
+}
+
+func (c Checker) checkMigrations(_ context.Context) (any, error) {
+	pending, err := migrations.Pending(c.pg)
+	if err != nil {
+		return nil, err
+	}
+
+	details := map[string]int{"pending": pending}
+	if pending > 0 {
+		return details, fmt.Errorf("%d migrations are not applied", pending)
+	}
+
+	return details, nil
+}
+
+// checkKafka is up if at least one of the brokers accepts a connection.
+func (c Checker) checkKafka(ctx context.Context) (any, error) {
+	if len(c.brokers) == 0 {
+		return nil, errors.New("no brokers configured")
+	}
+
+	dialer := &kafka.Dialer{Timeout: c.cfg.Timeout}
+
+	var errs []error
+	for _, broker := range c.brokers {
+		conn, err := dialer.DialContext(ctx, "tcp", broker)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", broker, err))
+			continue
+		}
+		_ = conn.Close()
+
+		return map[string]string{"broker": broker}, nil
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+func (c Checker) checkInbox(ctx context.Context) (any, error) {
+	oldest, err := c.inbox.GetOldestDueInboxEventTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var age time.Duration
+	if !oldest.IsZero() {
+		age = time.Since(oldest)
+	}
+
+	details := map[string]string{"oldest_due_age": age.Round(time.Second).String()}
+	if age > c.cfg.InboxMaxAge {
+		return details, fmt.Errorf("oldest due inbox event has been waiting for more than %s", c.cfg.InboxMaxAge)
+	}
+
+	return details, nil
+}
@@ -0,0 +1,65 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeInbox struct {
+	oldest time.Time
+	err    error
+}
+
+func (f fakeInbox) GetOldestDueInboxEventTime(_ context.Context) (time.Time, error) {
+	return f.oldest, f.err
+}
+
+func TestCheckInbox(t *testing.T) {
+	tests := []struct {
+		name       string
+		inbox      fakeInbox
+		wantStatus string
+	}{
+		{name: "no due events", inbox: fakeInbox{}, wantStatus: StatusUp},
+		{name: "due event is fresh", inbox: fakeInbox{oldest: time.Now().Add(-time.Minute)}, wantStatus: StatusUp},
+		{name: "due event waits too long", inbox: fakeInbox{oldest: time.Now().Add(-time.Hour)}, wantStatus: StatusDown},
+		{name: "database is down", inbox: fakeInbox{err: errors.New("connection refused")}, wantStatus: StatusDown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(nil, nil, tt.inbox, Config{})
+
+			res := c.run(context.Background(), c.checkInbox)
+			if res.Status != tt.wantStatus {
+				t.Fatalf("expected status %s, got %+v", tt.wantStatus, res)
+			}
+			if (res.Status == StatusDown) != (res.Error != "") {
+				t.Fatalf("expected an error exactly when down, got %+v", res)
+			}
+		})
+	}
+}
+
+func TestCheckKafka(t *testing.T) {
+	tests := []struct {
+		name       string
+		brokers    []string
+		wantStatus string
+	}{
+		{name: "no brokers", wantStatus: StatusDown},
+		{name: "unreachable broker", brokers: []string{"127.0.0.1:1"}, wantStatus: StatusDown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(nil, tt.brokers, fakeInbox{}, Config{Timeout: time.Second})
+
+			if res := c.run(context.Background(), c.checkKafka); res.Status != tt.wantStatus {
+				t.Fatalf("expected status %s, got %+v", tt.wantStatus, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package imaging
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gomedium"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+const identiconCells = 5
+
+var identiconBackground = color.RGBA{R: 0xF0, G: 0xF0, B: 0xF0, A: 0xFF}
+
+// Identicon draws a horizontally mirrored 5x5 pattern keyed by the seed, the same seed always
+// gives the same picture. The pattern sits on a light background with half a cell of margin.
+func Identicon(seed []byte, size int) *image.RGBA {
+	sum := sha256.Sum256(seed)
+	fg := seedColor(sum)
+
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(img, img.Bounds(), image.NewUniform(identiconBackground), image.Point{}, draw.Src)
+
+	cell := size / (identiconCells + 1)
+	margin := (size - cell*identiconCells) / 2
+
+	eachIdenticonCell(sum, func(col, row int) {
+		r := image.Rect(0, 0, cell, cell).Add(image.Pt(margin+col*cell, margin+row*cell))
+		draw.Draw(img, r, image.NewUniform(fg), image.Point{}, draw.Src)
+	})
+
+	return img
+}
+
+// IdenticonSVG is Identicon as a scalable SVG document.
+func IdenticonSVG(seed []byte) []byte {
+	sum := sha256.Sum256(seed)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, 2*(identiconCells+1), 2*(identiconCells+1))
+	fmt.Fprintf(&buf, `<rect width="100%%" height="100%%" fill="%s"/>`, hexColor(identiconBackground))
+	fmt.Fprintf(&buf, `<g fill="%s">`, hexColor(seedColor(sum)))
+	eachIdenticonCell(sum, func(col, row int) {
+		fmt.Fprintf(&buf, `<rect x="%d" y="%d" width="2" height="2"/>`, 1+2*col, 1+2*row)
+	})
+	buf.WriteString(`</g></svg>`)
+
+	return buf.Bytes()
+}
+
+// Initials draws up to two initials of the name in white over a color keyed by the seed.
+func Initials(name string, seed []byte, size int) (*image.RGBA, error) {
+	sum := sha256.Sum256(seed)
+
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(img, img.Bounds(), image.NewUniform(seedColor(sum)), image.Point{}, draw.Src)
+
+	text := initials(name)
+	if text == "" {
+		return img, nil
+	}
+
+	face, err := initialsFace(float64(size) * 0.42)
+	if err != nil {
+		return nil, err
+	}
+	defer face.Close()
+
+	d := font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(color.White),
+		Face: face,
+	}
+
+	metrics := face.Metrics()
+	width := d.MeasureString(text)
+	d.Dot = fixed.Point26_6{
+		X: (fixed.I(size) - width) / 2,
+		Y: (fixed.I(size) + metrics.CapHeight) / 2,
+	}
+	d.DrawString(text)
+
+	return img, nil
+}
+
+// InitialsSVG is Initials as a scalable SVG document.
+func InitialsSVG(name string, seed []byte) []byte {
+	sum := sha256.Sum256(seed)
+
+	var buf bytes.Buffer
+	buf.WriteString(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">`)
+	fmt.Fprintf(&buf, `<rect width="100%%" height="100%%" fill="%s"/>`, hexColor(seedColor(sum)))
+	if text := initials(name); text != "" {
+		buf.WriteString(`<text x="50" y="50" dy="0.35em" text-anchor="middle" fill="#FFFFFF" `)
+		buf.WriteString(`font-family="Helvetica, Arial, sans-serif" font-size="42" font-weight="500">`)
+		buf.WriteString(text)
+		buf.WriteString(`</text>`)
+	}
+	buf.WriteString(`</svg>`)
+
+	return buf.Bytes()
+}
+
+func EncodePNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("encoding png: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// eachIdenticonCell calls fn for every filled cell, the left three columns come from the hash
+// and the right two mirror them.
+func eachIdenticonCell(sum [sha256.Size]byte, fn func(col, row int)) {
+	half := (identiconCells + 1) / 2
+
+	for col := 0; col < half; col++ {
+		for row := 0; row < identiconCells; row++ {
+			// the first bytes pick the color, the pattern is read from the rest
+			if sum[4+col*identiconCells+row]&1 == 0 {
+				continue
+			}
+
+			fn(col, row)
+			if mirror := identiconCells - 1 - col; mirror != col {
+				fn(mirror, row)
+			}
+		}
+	}
+}
+
+// seedColor picks a hue from the hash, saturation and lightness are fixed so every color
+// reads well against both the light background and white text.
+func seedColor(sum [sha256.Size]byte) color.RGBA {
+	hue := float64(uint16(sum[0])<<8|uint16(sum[1])) / 65536 * 360
+
+	return hslColor(hue, 0.55, 0.5)
+}
+
+func hslColor(h, s, l float64) color.RGBA {
+	c := (1 - math.Abs(2*l-1)) * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - c/2
+
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+
+	return color.RGBA{
+		R: uint8(math.Round((r + m) * 255)),
+		G: uint8(math.Round((g + m) * 255)),
+		B: uint8(math.Round((b + m) * 255)),
+		A: 0xFF,
+	}
+}
+
+func hexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02X%02X%02X", c.R, c.G, c.B)
+}
+
+// initials takes the first letter or digit of the first two words of the name, words are split on
+// anything else, e.g. "john_doe" gives "JD".
+func initials(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var out []rune
+	for _, w := range words {
+		out = append(out, unicode.ToUpper([]rune(w)[0]))
+		if len(out) == 2 {
+			break
+		}
+	}
+
+	return string(out)
+}
+
+var initialsFont = sync.OnceValues(func() (*opentype.Font, error) {
+	return opentype.Parse(gomedium.TTF)
+})
+
+func initialsFace(size float64) (font.Face, error) {
+	f, err := initialsFont()
+	if err != nil {
+		return nil, fmt.Errorf("parsing initials font: %w", err)
+	}
+
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{
+		Size:    size,
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating initials font face: %w", err)
+	}
+
+	return face, nil
+}
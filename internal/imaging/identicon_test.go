@@ -0,0 +1,91 @@
+package imaging
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestIdenticon(t *testing.T) {
+	seed := uuid.New()
+
+	a, err := EncodePNG(Identicon(seed[:], 128))
+	if err != nil {
+		t.Fatalf("EncodePNG: %v", err)
+	}
+	b, err := EncodePNG(Identicon(seed[:], 128))
+	if err != nil {
+		t.Fatalf("EncodePNG: %v", err)
+	}
+	if !bytes.Equal(a, b) {
+		t.Fatalf("expected the same seed to give the same identicon")
+	}
+
+	other := uuid.New()
+	if bytes.Equal(IdenticonSVG(seed[:]), IdenticonSVG(other[:])) {
+		t.Fatalf("expected different seeds to give different identicons")
+	}
+	if !bytes.Equal(IdenticonSVG(seed[:]), IdenticonSVG(seed[:])) {
+		t.Fatalf("expected the same seed to give the same svg")
+	}
+
+	img, err := png.Decode(bytes.NewReader(a))
+	if err != nil {
+		t.Fatalf("decoding png: %v", err)
+	}
+	if bounds := img.Bounds(); bounds.Dx() != 128 || bounds.Dy() != 128 {
+		t.Fatalf("expected a 128x128 image, got %dx%d", bounds.Dx(), bounds.Dy())
+	}
+
+	// the pattern is mirrored around the vertical axis
+	rgba := Identicon(seed[:], 120)
+	for y := 0; y < 120; y++ {
+		for x := 0; x < 60; x++ {
+			if rgba.RGBAAt(x, y) != rgba.RGBAAt(119-x, y) {
+				t.Fatalf("expected pixel (%d, %d) to mirror (%d, %d)", x, y, 119-x, y)
+			}
+		}
+	}
+}
+
+func TestInitials(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "john_doe", want: "JD"},
+		{name: "alice", want: "A"},
+		{name: "ann.marie.smith", want: "AM"},
+		{name: "user_1a2b3c", want: "U1"},
+		{name: "élise-dupont", want: "ÉD"},
+		{name: "__", want: ""},
+		{name: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := initials(tt.name); got != tt.want {
+				t.Fatalf("initials(%q): expected %q, got %q", tt.name, tt.want, got)
+			}
+
+			svg := string(InitialsSVG(tt.name, []byte("seed")))
+			if tt.want != "" && !strings.Contains(svg, ">"+tt.want+"</text>") {
+				t.Fatalf("expected the svg to show %q, got %s", tt.want, svg)
+			}
+			if tt.want == "" && strings.Contains(svg, "<text") {
+				t.Fatalf("expected no text in the svg, got %s", svg)
+			}
+
+			img, err := Initials(tt.name, []byte("seed"), 64)
+			if err != nil {
+				t.Fatalf("Initials: %v", err)
+			}
+			if bounds := img.Bounds(); bounds.Dx() != 64 || bounds.Dy() != 64 {
+				t.Fatalf("expected a 64x64 image, got %dx%d", bounds.Dx(), bounds.Dy())
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+
+	_ "image/gif"
+	_ "image/png"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// ContentTypes are the sniffed content types Decode understands.
+var ContentTypes = []string{"image/jpeg", "image/png", "image/gif", "image/webp"}
+
+var ErrTooManyPixels = errors.New("image has too many pixels")
+
+// Decode reads the first frame of a JPEG, PNG, GIF or WebP image and turns it upright according to
+// its EXIF orientation. Images larger than maxPixels are rejected before their pixels are decoded.
+// Nothing besides pixels survives decoding, metadata is gone once the image is encoded again.
+func Decode(data []byte, maxPixels int) (image.Image, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decoding image header: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, fmt.Errorf("image has no pixels")
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, fmt.Errorf("%w: %dx%d, at most %d allowed", ErrTooManyPixels, cfg.Width, cfg.Height, maxPixels)
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decoding image: %w", err)
+	}
+
+	if format == "jpeg" {
+		img = orient(img, jpegOrientation(data))
+	}
+
+	return img, nil
+}
+
+// Cover crops the centered part of the image with the aspect ratio of width x height and scales
+// it to that size. Transparent pixels are laid over white, as the result is meant to be encoded as JPEG.
+func Cover(img image.Image, width, height int) *image.RGBA {
+	b := img.Bounds()
+
+	cw, ch := b.Dx(), b.Dx()*height/width
+	if ch > b.Dy() {
+		cw, ch = b.Dy()*width/height, b.Dy()
+	}
+	crop := image.Rect(0, 0, max(cw, 1), max(ch, 1)).Add(image.Pt(
+		b.Min.X+(b.Dx()-cw)/2,
+		b.Min.Y+(b.Dy()-ch)/2,
+	))
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, crop, draw.Over, nil)
+
+	return dst
+}
+
+func EncodeJPEG(img image.Image, quality int) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, fmt.Errorf("encoding jpeg: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "profiles_svc"
+
+const (
+	InboxResultProcessed = "processed"
+	InboxResultFailed    = "failed"
+	InboxResultDelayed   = "delayed"
+)
+
+var (
+	HTTPRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "HTTP requests by method, chi route pattern and status code.",
+	}, []string{"method", "route", "status"})
+
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by method and chi route pattern.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	DBQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "Database query latency by table and operation.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"table", "operation"})
+
+	InboxEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "inbox",
+		Name:      "events_total",
+		Help:      "Inbox events handled by the worker, by event type and result: processed, failed or delayed.",
+	}, []string{"type", "result"})
+
+	InboxBacklog = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "inbox",
+		Name:      "backlog_events",
+		Help:      "Inbox events waiting for processing.",
+	})
+
+	InboxOldestPendingAge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "inbox",
+		Name:      "oldest_pending_age_seconds",
+		Help:      "Age of the oldest inbox event waiting for processing, 0 if there is none.",
+	})
+
+	KafkaConsumerLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "kafka",
+		Name:      "consumer_lag_messages",
+		Help:      "Messages behind the partition high water mark, as of the last consumed message.",
+	}, []string{"topic", "partition"})
+)
+
+// Handler serves the default registry in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveQuery starts timing a database query, call the returned func when the query is done.
+func ObserveQuery(table, operation string) func() {
+	start := time.Now()
+	return func() {
+		DBQueryDuration.WithLabelValues(table, operation).Observe(time.Since(start).Seconds())
+	}
+}
+
+func ObserveKafkaLag(topic string, partition int, lag int64) {
+	KafkaConsumerLag.WithLabelValues(topic, strconv.Itoa(partition)).Set(float64(max(lag, 0)))
+}
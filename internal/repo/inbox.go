@@ -0,0 +1,141 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/kafkakit/box"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/domain/modules/inbox"
+)
+
+func (r *Repository) FilterInboxEvents(
+	ctx context.Context,
+	params inbox.FilterParams,
+	offset uint,
+	limit uint,
+) (entity.InboxEventCollection, error) {
+	q := r.sql.inbox.New()
+
+	if params.Status != nil {
+		q = q.FilterStatus(*params.Status)
+	}
+	if params.Type != nil {
+		q = q.FilterType(*params.Type)
+	}
+	if params.CreatedAfter != nil {
+		q = q.FilterCreatedAfter(*params.CreatedAfter)
+	}
+	if params.CreatedBefore != nil {
+		q = q.FilterCreatedBefore(*params.CreatedBefore)
+	}
+
+	rows, err := q.OrderCreatedAt(false).Page(limit, offset).Select(ctx)
+	if err != nil {
+		return entity.InboxEventCollection{}, err
+	}
+
+	total, err := q.Count(ctx)
+	if err != nil {
+		return entity.InboxEventCollection{}, err
+	}
+
+	collection := make([]box.InboxEvent, 0, len(rows))
+	for _, row := range rows {
+		collection = append(collection, row.ToContract())
+	}
+
+	return entity.InboxEventCollection{
+		Data:   collection,
+		Offset: offset,
+		Size:   limit,
+		Total:  uint(total),
+	}, nil
+}
+
+// ClaimInboxEvents takes due inbox events for processing, see pgdb.InboxEventsQ.Claim.
+func (r *Repository) ClaimInboxEvents(ctx context.Context, limit int32, lease time.Duration) ([]entity.ClaimedInboxEvent, error) {
+	rows, err := r.sql.inbox.New().Claim(ctx, uint(limit), lease)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]entity.ClaimedInboxEvent, 0, len(rows))
+	for _, row := range rows {
+		event := entity.ClaimedInboxEvent{InboxEvent: row.ToContract()}
+		if len(row.TraceContext) > 0 {
+			if err = json.Unmarshal(row.TraceContext, &event.TraceContext); err != nil {
+				return nil, fmt.Errorf("decoding trace context of inbox event %s: %w", row.ID, err)
+			}
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// RequeueFailedInboxEvents moves failed events back to pending with their attempts reset.
+func (r *Repository) RequeueFailedInboxEvents(ctx context.Context, ids []uuid.UUID) ([]box.InboxEvent, error) {
+	rows, err := r.sql.inbox.New().Requeue(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]box.InboxEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, row.ToContract())
+	}
+
+	return events, nil
+}
+
+func (r *Repository) SetInboxEventTraceContext(ctx context.Context, id uuid.UUID, traceContext map[string]string) error {
+	raw, err := json.Marshal(traceContext)
+	if err != nil {
+		return fmt.Errorf("encoding trace context: %w", err)
+	}
+
+	return r.sql.inbox.New().UpdateTraceContext(ctx, id, raw)
+}
+
+// GetOldestPendingInboxEvent returns the oldest event still waiting for processing, or an empty event if there is none.
+func (r *Repository) GetOldestPendingInboxEvent(ctx context.Context) (box.InboxEvent, error) {
+	rows, err := r.sql.inbox.New().
+		FilterStatus(box.InboxStatusPending, box.InboxStatusProcessing).
+		OrderCreatedAt(true).
+		Page(1, 0).
+		Select(ctx)
+	if err != nil {
+		return box.InboxEvent{}, err
+	}
+	if len(rows) == 0 {
+		return box.InboxEvent{}, nil
+	}
+
+	return rows[0].ToContract(), nil
+}
+
+// GetOldestDueInboxEventTime returns when the longest waiting claimable event became due, or the
+// zero time if none is waiting. Unlike the oldest pending event, it ignores events that are in retry
+// backoff or blocked behind an earlier event of their key.
+func (r *Repository) GetOldestDueInboxEventTime(ctx context.Context) (time.Time, error) {
+	oldest, err := r.sql.inbox.New().OldestDueAt(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if oldest == nil {
+		return time.Time{}, nil
+	}
+
+	return *oldest, nil
+}
+
+// CountPendingInboxEvents returns the number of events waiting for processing.
+func (r *Repository) CountPendingInboxEvents(ctx context.Context) (uint64, error) {
+	return r.sql.inbox.New().
+		FilterStatus(box.InboxStatusPending, box.InboxStatusProcessing).
+		Count(ctx)
+}
@@ -0,0 +1,108 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/events/contracts"
+	"github.com/umisto/profiles-svc/internal/repo/pgdb"
+)
+
+func (r *Repository) CreateOutboxEvent(ctx context.Context, event contracts.OutboxEvent) (contracts.OutboxEvent, error) {
+	res, err := r.sql.outbox.New().Insert(ctx, pgdb.OutboxEvent{
+		ID:       event.ID,
+		Topic:    event.Topic,
+		Key:      event.Key,
+		Type:     event.Type,
+		Version:  event.Version,
+		Producer: event.Producer,
+		Payload:  event.Payload,
+		Status:   contracts.OutboxStatusPending,
+	})
+	if err != nil {
+		return contracts.OutboxEvent{}, err
+	}
+
+	return res.ToContract(), nil
+}
+
+// GetPendingOutboxEvents returns pending events which are due to be sent, oldest first. Only the
+// oldest unsent event of every key is returned, so events of one key go out one by one in order
+// and none of them goes out after an earlier one failed for good.
+// When called inside a transaction the returned rows stay locked until it ends.
+func (r *Repository) GetPendingOutboxEvents(ctx context.Context, limit int32) ([]contracts.OutboxEvent, error) {
+	rows, err := r.sql.outbox.New().
+		FilterStatus(contracts.OutboxStatusPending).
+		FilterReadyToSend(time.Now().UTC()).
+		FilterFirstOfKey().
+		OrderCreatedAt(true).
+		Page(uint(limit), 0).
+		ForUpdateSkipLocked().
+		Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return outboxEventsToContracts(rows), nil
+}
+
+func (r *Repository) MarkOutboxEventsAsSent(ctx context.Context, ids []uuid.UUID) ([]contracts.OutboxEvent, error) {
+	now := time.Now().UTC()
+
+	rows, err := r.sql.outbox.New().
+		FilterID(ids...).
+		UpdateStatus(contracts.OutboxStatusSent).
+		IncrementAttempts().
+		UpdateNextRetryAt(nil).
+		UpdateSentAt(&now).
+		Update(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return outboxEventsToContracts(rows), nil
+}
+
+func (r *Repository) MarkOutboxEventsAsFailed(ctx context.Context, ids []uuid.UUID) ([]contracts.OutboxEvent, error) {
+	rows, err := r.sql.outbox.New().
+		FilterID(ids...).
+		UpdateStatus(contracts.OutboxStatusFailed).
+		IncrementAttempts().
+		UpdateNextRetryAt(nil).
+		Update(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return outboxEventsToContracts(rows), nil
+}
+
+func (r *Repository) MarkOutboxEventsAsPending(
+	ctx context.Context,
+	ids []uuid.UUID,
+	delay time.Duration,
+) ([]contracts.OutboxEvent, error) {
+	nextRetryAt := time.Now().UTC().Add(delay)
+
+	rows, err := r.sql.outbox.New().
+		FilterID(ids...).
+		UpdateStatus(contracts.OutboxStatusPending).
+		IncrementAttempts().
+		UpdateNextRetryAt(&nextRetryAt).
+		Update(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return outboxEventsToContracts(rows), nil
+}
+
+func outboxEventsToContracts(rows []pgdb.OutboxEvent) []contracts.OutboxEvent {
+	events := make([]contracts.OutboxEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, row.ToContract())
+	}
+
+	return events
+}
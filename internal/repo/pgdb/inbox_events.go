@@ -0,0 +1,326 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/umisto/kafkakit/box"
+)
+
+const inboxEventsTable = "inbox_events"
+
+const inboxEventsColumns = "id, topic, key, type, version, producer, payload, status, attempts, created_at, next_retry_at, processed_at, trace_context"
+
+// InboxEvent is a read model of the inbox table, which is written by kafkakit's box.
+// Only trace_context, which box does not know about, is written by this service.
+type InboxEvent struct {
+	ID          uuid.UUID  `db:"id"`
+	Topic       string     `db:"topic"`
+	Key         string     `db:"key"`
+	Type        string     `db:"type"`
+	Version     int32      `db:"version"`
+	Producer    string     `db:"producer"`
+	Payload     []byte     `db:"payload"`
+	Status      string     `db:"status"`
+	Attempts    int32      `db:"attempts"`
+	CreatedAt   time.Time  `db:"created_at"`
+	NextRetryAt *time.Time `db:"next_retry_at"`
+	ProcessedAt *time.Time `db:"processed_at"`
+
+	TraceContext []byte `db:"trace_context"`
+}
+
+type InboxEventsQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewInboxEventsQ(db *sql.DB) InboxEventsQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return InboxEventsQ{
+		db:       db,
+		selector: builder.Select(inboxEventsColumns).From(inboxEventsTable),
+		counter:  builder.Select("COUNT(*) AS count").From(inboxEventsTable),
+	}
+}
+
+func (q InboxEventsQ) New() InboxEventsQ {
+	return NewInboxEventsQ(q.db)
+}
+
+func (q InboxEventsQ) Select(ctx context.Context) ([]InboxEvent, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building select query for %s: %w", inboxEventsTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []InboxEvent
+	for rows.Next() {
+		e, err := scanInboxEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning inbox event: %w", err)
+		}
+		out = append(out, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (q InboxEventsQ) FilterStatus(status ...string) InboxEventsQ {
+	q.selector = q.selector.Where(sq.Eq{"status": status})
+	q.counter = q.counter.Where(sq.Eq{"status": status})
+	return q
+}
+
+func (q InboxEventsQ) FilterType(eventType ...string) InboxEventsQ {
+	q.selector = q.selector.Where(sq.Eq{"type": eventType})
+	q.counter = q.counter.Where(sq.Eq{"type": eventType})
+	return q
+}
+
+func (q InboxEventsQ) FilterCreatedAfter(t time.Time) InboxEventsQ {
+	q.selector = q.selector.Where(sq.Gt{"created_at": t})
+	q.counter = q.counter.Where(sq.Gt{"created_at": t})
+	return q
+}
+
+func (q InboxEventsQ) FilterCreatedBefore(t time.Time) InboxEventsQ {
+	q.selector = q.selector.Where(sq.Lt{"created_at": t})
+	q.counter = q.counter.Where(sq.Lt{"created_at": t})
+	return q
+}
+
+func (q InboxEventsQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", inboxEventsTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q InboxEventsQ) Page(limit, offset uint) InboxEventsQ {
+	q.selector = q.selector.Limit(uint64(limit)).Offset(uint64(offset))
+	return q
+}
+
+func (q InboxEventsQ) OrderCreatedAt(ascending bool) InboxEventsQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("created_at ASC")
+	} else {
+		q.selector = q.selector.OrderBy("created_at DESC")
+	}
+	return q
+}
+
+// UpdateTraceContext stores the W3C trace context the event was received with.
+func (q InboxEventsQ) UpdateTraceContext(ctx context.Context, id uuid.UUID, traceContext []byte) error {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(inboxEventsTable).
+		Set("trace_context", traceContext).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building update query for %s: %w", inboxEventsTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+// Requeue moves failed events back to pending, due right away and with their attempts reset, so
+// they get the full number of retries again. Events in any other status are left untouched.
+func (q InboxEventsQ) Requeue(ctx context.Context, ids ...uuid.UUID) ([]InboxEvent, error) {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(inboxEventsTable).
+		Set("status", box.InboxStatusPending).
+		Set("attempts", 0).
+		Set("next_retry_at", nil).
+		Where(sq.Eq{"id": ids, "status": box.InboxStatusFailed}).
+		Suffix("RETURNING " + inboxEventsColumns).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building requeue query for %s: %w", inboxEventsTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []InboxEvent
+	for rows.Next() {
+		e, err := scanInboxEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning inbox event: %w", err)
+		}
+		out = append(out, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// inboxClaimable matches the events Claim may take at the time bound to now: pending events past
+// their retry time and processing ones whose lease expired, each only if it is the oldest
+// unfinished event of its key.
+func inboxClaimable(now string) string {
+	return `(
+			(e.status = 'pending' AND (e.next_retry_at IS NULL OR e.next_retry_at <= ` + now + `))
+			OR (e.status = 'processing' AND e.next_retry_at <= ` + now + `)
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM ` + inboxEventsTable + ` p
+			WHERE p.key = e.key
+			AND p.status IN ('pending', 'processing')
+			AND (p.created_at, p.id) < (e.created_at, e.id)
+		)`
+}
+
+// OldestDueAt returns when the longest waiting claimable event became due, that is its retry or
+// lease expiry time, or its creation if it was never tried. Nil is returned if nothing is due.
+// Events in retry backoff and events queued behind another of their key are not waiting on the
+// worker, so they do not count.
+func (q InboxEventsQ) OldestDueAt(ctx context.Context) (*time.Time, error) {
+	query := `
+		SELECT MIN(COALESCE(e.next_retry_at, e.created_at)) FROM ` + inboxEventsTable + ` e
+		WHERE ` + inboxClaimable("$1")
+
+	var oldest sql.NullTime
+	var err error
+	now := time.Now().UTC()
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, now).Scan(&oldest)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, now).Scan(&oldest)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting oldest due %s: %w", inboxEventsTable, err)
+	}
+	if !oldest.Valid {
+		return nil, nil
+	}
+
+	return &oldest.Time, nil
+}
+
+// Claim marks up to limit due events as processing for the lease duration and returns them, oldest first.
+// Only the oldest unfinished event of every key can be claimed, so events of one key are never
+// processed concurrently or out of order, and rows locked by other workers are skipped.
+// Events whose lease expired, e.g. because their worker died, are claimed again and count that as
+// a failed attempt, so an event which keeps crashing or hanging its worker runs out of attempts.
+func (q InboxEventsQ) Claim(ctx context.Context, limit uint, lease time.Duration) ([]InboxEvent, error) {
+	now := time.Now().UTC()
+
+	query := `
+		UPDATE ` + inboxEventsTable + `
+		SET status = 'processing', next_retry_at = $1,
+			attempts = attempts + CASE WHEN status = 'processing' THEN 1 ELSE 0 END
+		WHERE id IN (
+			SELECT e.id FROM ` + inboxEventsTable + ` e
+			WHERE ` + inboxClaimable("$2") + `
+			ORDER BY e.created_at, e.id
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + inboxEventsColumns
+
+	var rows *sql.Rows
+	var err error
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, now.Add(lease), now, limit)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, now.Add(lease), now, limit)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("claiming %s: %w", inboxEventsTable, err)
+	}
+	defer rows.Close()
+
+	var out []InboxEvent
+	for rows.Next() {
+		e, err := scanInboxEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning inbox event: %w", err)
+		}
+		out = append(out, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(out, func(a, b InboxEvent) int { return a.CreatedAt.Compare(b.CreatedAt) })
+
+	return out, nil
+}
+
+func scanInboxEvent(row rowScanner) (InboxEvent, error) {
+	var e InboxEvent
+	err := row.Scan(
+		&e.ID,
+		&e.Topic,
+		&e.Key,
+		&e.Type,
+		&e.Version,
+		&e.Producer,
+		&e.Payload,
+		&e.Status,
+		&e.Attempts,
+		&e.CreatedAt,
+		&e.NextRetryAt,
+		&e.ProcessedAt,
+		&e.TraceContext,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return InboxEvent{}, nil
+		}
+		return InboxEvent{}, err
+	}
+
+	return e, nil
+}
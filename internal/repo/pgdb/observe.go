@@ -0,0 +1,24 @@
+package pgdb
+
+import (
+	"context"
+
+	"github.com/umisto/profiles-svc/internal/metrics"
+	"github.com/umisto/profiles-svc/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// observeQuery traces and times a query, call the returned func once the query is done.
+func observeQuery(ctx context.Context, table, operation string) (context.Context, func()) {
+	ctx, span := tracing.Start(ctx, "db."+table+"."+operation,
+		attribute.String("db.system.name", "postgresql"),
+		attribute.String("db.collection.name", table),
+		attribute.String("db.operation.name", operation),
+	)
+	done := metrics.ObserveQuery(table, operation)
+
+	return ctx, func() {
+		done()
+		span.End()
+	}
+}
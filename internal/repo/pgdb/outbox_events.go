@@ -0,0 +1,318 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const outboxEventsTable = "outbox_events"
+
+const outboxEventsColumns = "id, topic, key, type, version, producer, payload, status, attempts, created_at, next_retry_at, sent_at"
+
+type OutboxEvent struct {
+	ID          uuid.UUID       `db:"id"`
+	Topic       string          `db:"topic"`
+	Key         string          `db:"key"`
+	Type        string          `db:"type"`
+	Version     int32           `db:"version"`
+	Producer    string          `db:"producer"`
+	Payload     json.RawMessage `db:"payload"`
+	Status      string          `db:"status"`
+	Attempts    int32           `db:"attempts"`
+	CreatedAt   time.Time       `db:"created_at"`
+	NextRetryAt *time.Time      `db:"next_retry_at"`
+	SentAt      *time.Time      `db:"sent_at"`
+}
+
+type OutboxEventsQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewOutboxEventsQ(db *sql.DB) OutboxEventsQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return OutboxEventsQ{
+		db:       db,
+		selector: builder.Select(outboxEventsColumns).From(outboxEventsTable),
+		inserter: builder.Insert(outboxEventsTable),
+		updater:  builder.Update(outboxEventsTable),
+		deleter:  builder.Delete(outboxEventsTable),
+		counter:  builder.Select("COUNT(*) AS count").From(outboxEventsTable),
+	}
+}
+
+func (q OutboxEventsQ) New() OutboxEventsQ {
+	return NewOutboxEventsQ(q.db)
+}
+
+func (q OutboxEventsQ) Insert(ctx context.Context, input OutboxEvent) (OutboxEvent, error) {
+	values := map[string]interface{}{
+		"topic":    input.Topic,
+		"key":      input.Key,
+		"type":     input.Type,
+		"version":  input.Version,
+		"producer": input.Producer,
+		"payload":  string(input.Payload),
+		"status":   input.Status,
+	}
+	if input.ID != uuid.Nil {
+		values["id"] = input.ID
+	}
+
+	query, args, err := q.inserter.
+		SetMap(values).
+		Suffix("RETURNING " + outboxEventsColumns).
+		ToSql()
+	if err != nil {
+		return OutboxEvent{}, fmt.Errorf("building insert query for %s: %w", outboxEventsTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	return scanOutboxEvent(row)
+}
+
+func (q OutboxEventsQ) Update(ctx context.Context) ([]OutboxEvent, error) {
+	query, args, err := q.updater.
+		Suffix("RETURNING " + outboxEventsColumns).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building update query for %s: %w", outboxEventsTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []OutboxEvent
+	for rows.Next() {
+		e, err := scanOutboxEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning outbox event: %w", err)
+		}
+		out = append(out, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (q OutboxEventsQ) UpdateStatus(status string) OutboxEventsQ {
+	q.updater = q.updater.Set("status", status)
+	return q
+}
+
+func (q OutboxEventsQ) UpdateNextRetryAt(nextRetryAt *time.Time) OutboxEventsQ {
+	q.updater = q.updater.Set("next_retry_at", nextRetryAt)
+	return q
+}
+
+func (q OutboxEventsQ) UpdateSentAt(sentAt *time.Time) OutboxEventsQ {
+	q.updater = q.updater.Set("sent_at", sentAt)
+	return q
+}
+
+func (q OutboxEventsQ) IncrementAttempts() OutboxEventsQ {
+	q.updater = q.updater.Set("attempts", sq.Expr("attempts + 1"))
+	return q
+}
+
+func (q OutboxEventsQ) Get(ctx context.Context) (OutboxEvent, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return OutboxEvent{}, fmt.Errorf("building get query for %s: %w", outboxEventsTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	return scanOutboxEvent(row)
+}
+
+func (q OutboxEventsQ) Select(ctx context.Context) ([]OutboxEvent, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building select query for %s: %w", outboxEventsTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []OutboxEvent
+	for rows.Next() {
+		e, err := scanOutboxEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning outbox event: %w", err)
+		}
+		out = append(out, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (q OutboxEventsQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", outboxEventsTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
+
+func (q OutboxEventsQ) FilterID(id ...uuid.UUID) OutboxEventsQ {
+	q.selector = q.selector.Where(sq.Eq{"id": id})
+	q.counter = q.counter.Where(sq.Eq{"id": id})
+	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.updater = q.updater.Where(sq.Eq{"id": id})
+	return q
+}
+
+func (q OutboxEventsQ) FilterStatus(status ...string) OutboxEventsQ {
+	q.selector = q.selector.Where(sq.Eq{"status": status})
+	q.counter = q.counter.Where(sq.Eq{"status": status})
+	q.deleter = q.deleter.Where(sq.Eq{"status": status})
+	q.updater = q.updater.Where(sq.Eq{"status": status})
+	return q
+}
+
+// FilterReadyToSend keeps events that have no scheduled retry or whose retry time has come.
+func (q OutboxEventsQ) FilterReadyToSend(now time.Time) OutboxEventsQ {
+	cond := sq.Or{sq.Eq{"next_retry_at": nil}, sq.LtOrEq{"next_retry_at": now}}
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	return q
+}
+
+// FilterFirstOfKey keeps only the oldest unsent event of every key. While an earlier event of a key
+// waits for a retry or is locked by another worker, later ones are held back, so they are never
+// published ahead of it. A failed event holds its key back too, until it is set pending again or
+// removed by hand.
+func (q OutboxEventsQ) FilterFirstOfKey() OutboxEventsQ {
+	cond := sq.Expr(`NOT EXISTS (
+		SELECT 1 FROM ` + outboxEventsTable + ` p
+		WHERE p.key = ` + outboxEventsTable + `.key
+		AND p.status IN ('pending', 'failed')
+		AND (p.created_at, p.id) < (` + outboxEventsTable + `.created_at, ` + outboxEventsTable + `.id)
+	)`)
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	return q
+}
+
+func (q OutboxEventsQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", outboxEventsTable, err)
+	}
+
+	var count uint64
+	if tx, ok := TxFromCtx(ctx); ok {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
+	} else {
+		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (q OutboxEventsQ) Page(limit, offset uint) OutboxEventsQ {
+	q.selector = q.selector.Limit(uint64(limit)).Offset(uint64(offset))
+	return q
+}
+
+func (q OutboxEventsQ) OrderCreatedAt(ascending bool) OutboxEventsQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("created_at ASC")
+	} else {
+		q.selector = q.selector.OrderBy("created_at DESC")
+	}
+	return q
+}
+
+// ForUpdateSkipLocked locks selected rows for the current transaction and skips rows
+// already locked by other workers, so several replicas can drain the outbox concurrently.
+func (q OutboxEventsQ) ForUpdateSkipLocked() OutboxEventsQ {
+	q.selector = q.selector.Suffix("FOR UPDATE SKIP LOCKED")
+	return q
+}
+
+func scanOutboxEvent(row rowScanner) (OutboxEvent, error) {
+	var e OutboxEvent
+	var payload []byte
+	err := row.Scan(
+		&e.ID,
+		&e.Topic,
+		&e.Key,
+		&e.Type,
+		&e.Version,
+		&e.Producer,
+		&payload,
+		&e.Status,
+		&e.Attempts,
+		&e.CreatedAt,
+		&e.NextRetryAt,
+		&e.SentAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return OutboxEvent{}, nil
+		}
+		return OutboxEvent{}, err
+	}
+	e.Payload = payload
+
+	return e, nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/umisto/kafkakit/box"
 	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/events/contracts"
 )
 
 type txKeyType struct{}
@@ -16,17 +18,88 @@ func TxFromCtx(ctx context.Context) (*sql.Tx, bool) {
 	return tx, ok
 }
 
+// rowScanner is implemented by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
 func (p Profile) ToEntity() entity.Profile {
 	profile := entity.Profile{
-		AccountID:   p.AccountID,
-		Username:    p.Username,
-		Official:    p.Official,
-		Pseudonym:   p.Pseudonym,
-		Description: p.Description,
-		Avatar:      p.Avatar,
+		AccountID:         p.AccountID,
+		Username:          p.Username,
+		UsernameUpdatedAt: p.UsernameUpdatedAt,
+		Official:          p.Official,
+		Pseudonym:         p.Pseudonym,
+		Description:       p.Description,
+		Avatar:            p.Avatar,
+		Banner:            p.Banner,
+		AccentColor:       p.AccentColor,
+		Sex:               p.Sex,
+		BirthDate:         p.BirthDate,
+		Hidden:            p.Hidden,
+		Version:           p.Version,
 
 		CreatedAt: p.CreatedAt,
 		UpdatedAt: p.UpdatedAt,
 	}
 	return profile
 }
+
+func (e OutboxEvent) ToContract() contracts.OutboxEvent {
+	return contracts.OutboxEvent{
+		ID:       e.ID,
+		Topic:    e.Topic,
+		Key:      e.Key,
+		Type:     e.Type,
+		Version:  e.Version,
+		Producer: e.Producer,
+		Payload:  e.Payload,
+
+		Status:   e.Status,
+		Attempts: e.Attempts,
+
+		CreatedAt:   e.CreatedAt,
+		NextRetryAt: e.NextRetryAt,
+		SentAt:      e.SentAt,
+	}
+}
+
+func (e InboxEvent) ToContract() box.InboxEvent {
+	return box.InboxEvent{
+		ID:       e.ID,
+		Topic:    e.Topic,
+		Key:      e.Key,
+		Type:     e.Type,
+		Version:  e.Version,
+		Producer: e.Producer,
+		Payload:  e.Payload,
+
+		Status:   e.Status,
+		Attempts: e.Attempts,
+
+		CreatedAt:   e.CreatedAt,
+		NextRetryAt: e.NextRetryAt,
+		ProcessedAt: e.ProcessedAt,
+	}
+}
+
+func (r ProfileReset) ToEntity() entity.ProfileReset {
+	return entity.ProfileReset{
+		ID:            r.ID,
+		AccountID:     r.AccountID,
+		InitiatorID:   r.InitiatorID,
+		Reason:        r.Reason,
+		UsernameReset: r.UsernameReset,
+
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func (u RetiredUsername) ToEntity() entity.RetiredUsername {
+	return entity.RetiredUsername{
+		ID:        u.ID,
+		AccountID: u.AccountID,
+		Username:  u.Username,
+		RetiredAt: u.RetiredAt,
+	}
+}
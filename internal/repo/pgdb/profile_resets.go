@@ -0,0 +1,137 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const profileResetsTable = "profile_resets"
+
+const profileResetsColumns = "id, account_id, initiator_id, reason, username_reset, created_at"
+
+type ProfileReset struct {
+	ID            uuid.UUID `db:"id"`
+	AccountID     uuid.UUID `db:"account_id"`
+	InitiatorID   uuid.UUID `db:"initiator_id"`
+	Reason        string    `db:"reason"`
+	UsernameReset bool      `db:"username_reset"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+type ProfileResetsQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+}
+
+func NewProfileResetsQ(db *sql.DB) ProfileResetsQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return ProfileResetsQ{
+		db:       db,
+		selector: builder.Select(profileResetsColumns).From(profileResetsTable),
+		inserter: builder.Insert(profileResetsTable),
+	}
+}
+
+func (q ProfileResetsQ) New() ProfileResetsQ {
+	return NewProfileResetsQ(q.db)
+}
+
+func (q ProfileResetsQ) Insert(ctx context.Context, input ProfileReset) (ProfileReset, error) {
+	values := map[string]interface{}{
+		"account_id":     input.AccountID,
+		"initiator_id":   input.InitiatorID,
+		"reason":         input.Reason,
+		"username_reset": input.UsernameReset,
+	}
+
+	query, args, err := q.inserter.
+		SetMap(values).
+		Suffix("RETURNING " + profileResetsColumns).
+		ToSql()
+	if err != nil {
+		return ProfileReset{}, fmt.Errorf("building insert query for %s: %w", profileResetsTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var r ProfileReset
+	err = row.Scan(
+		&r.ID,
+		&r.AccountID,
+		&r.InitiatorID,
+		&r.Reason,
+		&r.UsernameReset,
+		&r.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ProfileReset{}, nil
+		}
+		return ProfileReset{}, err
+	}
+
+	return r, nil
+}
+
+func (q ProfileResetsQ) Select(ctx context.Context) ([]ProfileReset, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building select query for %s: %w", profileResetsTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []ProfileReset
+	for rows.Next() {
+		var r ProfileReset
+		err = rows.Scan(
+			&r.ID,
+			&r.AccountID,
+			&r.InitiatorID,
+			&r.Reason,
+			&r.UsernameReset,
+			&r.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning profile reset: %w", err)
+		}
+		out = append(out, r)
+	}
+
+	return out, nil
+}
+
+func (q ProfileResetsQ) FilterAccountID(accountID ...uuid.UUID) ProfileResetsQ {
+	q.selector = q.selector.Where(sq.Eq{"account_id": accountID})
+	return q
+}
+
+func (q ProfileResetsQ) OrderCreatedAt(ascending bool) ProfileResetsQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("created_at ASC")
+	} else {
+		q.selector = q.selector.OrderBy("created_at DESC")
+	}
+	return q
+}
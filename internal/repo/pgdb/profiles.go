@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -13,15 +14,30 @@ import (
 
 const profilesTable = "profiles"
 
+const profilesColumns = "account_id, username, username_updated_at, official, pseudonym, description, avatar, banner, accent_color, sex, birth_date, hidden, version, created_at, updated_at"
+
 type Profile struct {
-	AccountID   uuid.UUID `db:"account_id"`
-	Username    string    `db:"username"`
-	Official    bool      `db:"official"`
-	Pseudonym   *string   `db:"pseudonym"`
-	Description *string   `db:"description"`
-	Avatar      *string   `db:"avatar"`
-	CreatedAt   time.Time `db:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at"`
+	AccountID uuid.UUID `db:"account_id"`
+	Username  string    `db:"username"`
+	// UsernameUpdatedAt is when the accounts service changed the username, used to order changes.
+	UsernameUpdatedAt *time.Time `db:"username_updated_at"`
+	Official          bool       `db:"official"`
+	Pseudonym         *string    `db:"pseudonym"`
+	Description       *string    `db:"description"`
+	Avatar            *string    `db:"avatar"`
+	Banner            *string    `db:"banner"`
+	AccentColor       *string    `db:"accent_color"`
+	Sex               *string    `db:"sex"`
+	BirthDate         *time.Time `db:"birth_date"`
+	Hidden            bool       `db:"hidden"`
+	Version           int64      `db:"version"`
+	CreatedAt         time.Time  `db:"created_at"`
+	UpdatedAt         time.Time  `db:"updated_at"`
+}
+
+type RankedProfile struct {
+	Profile
+	Score float64 `db:"score"`
 }
 
 type ProfilesQ struct {
@@ -37,7 +53,7 @@ func NewProfilesQ(db *sql.DB) ProfilesQ {
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 	return ProfilesQ{
 		db:       db,
-		selector: builder.Select("*").From(profilesTable),
+		selector: builder.Select(profilesColumns).From(profilesTable),
 		inserter: builder.Insert(profilesTable),
 		updater:  builder.Update(profilesTable),
 		deleter:  builder.Delete(profilesTable),
@@ -50,20 +66,37 @@ func (q ProfilesQ) New() ProfilesQ {
 }
 
 func (q ProfilesQ) Insert(ctx context.Context, input Profile) (Profile, error) {
+	ctx, done := observeQuery(ctx, profilesTable, "insert")
+	defer done()
+
+	now := time.Now().UTC()
+	if input.CreatedAt.IsZero() {
+		input.CreatedAt = now
+	}
+	if input.UpdatedAt.IsZero() {
+		input.UpdatedAt = now
+	}
+
 	values := map[string]interface{}{
-		"account_id":  input.AccountID,
-		"username":    input.Username,
-		"official":    input.Official,
-		"pseudonym":   input.Pseudonym,
-		"description": input.Description,
-		"avatar":      input.Avatar,
-		"created_at":  input.CreatedAt,
-		"updated_at":  input.UpdatedAt,
+		"account_id":          input.AccountID,
+		"username":            input.Username,
+		"username_updated_at": input.UsernameUpdatedAt,
+		"official":            input.Official,
+		"pseudonym":           input.Pseudonym,
+		"description":         input.Description,
+		"avatar":              input.Avatar,
+		"banner":              input.Banner,
+		"accent_color":        input.AccentColor,
+		"sex":                 input.Sex,
+		"birth_date":          input.BirthDate,
+		"hidden":              input.Hidden,
+		"created_at":          input.CreatedAt,
+		"updated_at":          input.UpdatedAt,
 	}
 
 	query, args, err := q.inserter.
 		SetMap(values).
-		Suffix("RETURNING account_id, username, official, pseudonym, description, avatar, created_at, updated_at").
+		Suffix("RETURNING " + profilesColumns).
 		ToSql()
 	if err != nil {
 		return Profile{}, fmt.Errorf("building insert query for %s: %w", profilesTable, err)
@@ -76,32 +109,26 @@ func (q ProfilesQ) Insert(ctx context.Context, input Profile) (Profile, error) {
 		row = q.db.QueryRowContext(ctx, query, args...)
 	}
 
-	var p Profile
-	err = row.Scan(
-		&p.AccountID,
-		&p.Username,
-		&p.Official,
-		&p.Pseudonym,
-		&p.Description,
-		&p.Avatar,
-		&p.CreatedAt,
-		&p.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return Profile{}, nil
-		}
-		return Profile{}, err
-	}
+	return scanProfile(row)
+}
 
-	return p, nil
+// OnConflictDoNothing makes Insert skip profiles whose account already has one,
+// Insert then returns an empty profile.
+func (q ProfilesQ) OnConflictDoNothing() ProfilesQ {
+	q.inserter = q.inserter.Suffix("ON CONFLICT (account_id) DO NOTHING")
+	return q
 }
 
 func (q ProfilesQ) Update(ctx context.Context) ([]Profile, error) {
-	q.updater = q.updater.Set("updated_at", time.Now().UTC())
+	ctx, done := observeQuery(ctx, profilesTable, "update")
+	defer done()
+
+	q.updater = q.updater.
+		Set("updated_at", time.Now().UTC()).
+		Set("version", sq.Expr("version + 1"))
 
 	query, args, err := q.updater.
-		Suffix("RETURNING account_id, username, official, pseudonym, description, avatar, created_at, updated_at").
+		Suffix("RETURNING " + profilesColumns).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("building update query for %s: %w", profilesTable, err)
@@ -120,17 +147,7 @@ func (q ProfilesQ) Update(ctx context.Context) ([]Profile, error) {
 
 	var out []Profile
 	for rows.Next() {
-		var p Profile
-		err = rows.Scan(
-			&p.AccountID,
-			&p.Username,
-			&p.Official,
-			&p.Pseudonym,
-			&p.Description,
-			&p.Avatar,
-			&p.CreatedAt,
-			&p.UpdatedAt,
-		)
+		p, err := scanProfile(rows)
 		if err != nil {
 			return nil, fmt.Errorf("scanning profile: %w", err)
 		}
@@ -143,11 +160,16 @@ func (q ProfilesQ) Update(ctx context.Context) ([]Profile, error) {
 	return out, nil
 }
 
+// UpdateOne updates exactly one profile. Combined with FilterVersion it works as a compare-and-swap,
+// sql.ErrNoRows is returned when no row matched, e.g. because the version has already moved on.
 func (q ProfilesQ) UpdateOne(ctx context.Context) (Profile, error) {
 	rows, err := q.Update(ctx)
 	if err != nil {
 		return Profile{}, err
 	}
+	if len(rows) == 0 {
+		return Profile{}, sql.ErrNoRows
+	}
 	if len(rows) != 1 {
 		return Profile{}, fmt.Errorf("expected 1 profile to be updated, got %d", len(rows))
 	}
@@ -159,11 +181,18 @@ func (q ProfilesQ) UpdateUsername(username string) ProfilesQ {
 	return q
 }
 
+func (q ProfilesQ) UpdateUsernameUpdatedAt(t *time.Time) ProfilesQ {
+	q.updater = q.updater.Set("username_updated_at", t)
+	return q
+}
+
 func (q ProfilesQ) UpdateOfficial(official bool) ProfilesQ {
 	q.updater = q.updater.Set("official", official)
 	return q
 }
 
+// The updaters of nullable columns below set the column to NULL when given a nil pointer.
+
 func (q ProfilesQ) UpdatePseudonym(pseudonym *string) ProfilesQ {
 	q.updater = q.updater.Set("pseudonym", pseudonym)
 	return q
@@ -179,7 +208,35 @@ func (q ProfilesQ) UpdateAvatar(avatar *string) ProfilesQ {
 	return q
 }
 
+func (q ProfilesQ) UpdateBanner(banner *string) ProfilesQ {
+	q.updater = q.updater.Set("banner", banner)
+	return q
+}
+
+func (q ProfilesQ) UpdateAccentColor(accentColor *string) ProfilesQ {
+	q.updater = q.updater.Set("accent_color", accentColor)
+	return q
+}
+
+func (q ProfilesQ) UpdateSex(sex *string) ProfilesQ {
+	q.updater = q.updater.Set("sex", sex)
+	return q
+}
+
+func (q ProfilesQ) UpdateBirthDate(birthDate *time.Time) ProfilesQ {
+	q.updater = q.updater.Set("birth_date", birthDate)
+	return q
+}
+
+func (q ProfilesQ) UpdateHidden(hidden bool) ProfilesQ {
+	q.updater = q.updater.Set("hidden", hidden)
+	return q
+}
+
 func (q ProfilesQ) Get(ctx context.Context) (Profile, error) {
+	ctx, done := observeQuery(ctx, profilesTable, "get")
+	defer done()
+
 	query, args, err := q.selector.Limit(1).ToSql()
 	if err != nil {
 		return Profile{}, fmt.Errorf("building get query for %s: %w", profilesTable, err)
@@ -192,28 +249,13 @@ func (q ProfilesQ) Get(ctx context.Context) (Profile, error) {
 		row = q.db.QueryRowContext(ctx, query, args...)
 	}
 
-	var p Profile
-	err = row.Scan(
-		&p.AccountID,
-		&p.Username,
-		&p.Official,
-		&p.Pseudonym,
-		&p.Description,
-		&p.Avatar,
-		&p.CreatedAt,
-		&p.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return Profile{}, nil
-		}
-		return Profile{}, err
-	}
-
-	return p, nil
+	return scanProfile(row)
 }
 
 func (q ProfilesQ) Select(ctx context.Context) ([]Profile, error) {
+	ctx, done := observeQuery(ctx, profilesTable, "select")
+	defer done()
+
 	query, args, err := q.selector.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("building select query for %s: %w", profilesTable, err)
@@ -232,17 +274,7 @@ func (q ProfilesQ) Select(ctx context.Context) ([]Profile, error) {
 
 	var out []Profile
 	for rows.Next() {
-		var p Profile
-		err = rows.Scan(
-			&p.AccountID,
-			&p.Username,
-			&p.Official,
-			&p.Pseudonym,
-			&p.Description,
-			&p.Avatar,
-			&p.CreatedAt,
-			&p.UpdatedAt,
-		)
+		p, err := scanProfile(rows)
 		if err != nil {
 			return nil, fmt.Errorf("scanning profile: %w", err)
 		}
@@ -252,7 +284,55 @@ func (q ProfilesQ) Select(ctx context.Context) ([]Profile, error) {
 	return out, nil
 }
 
+// SelectRanked selects profiles together with their relevance score for the text,
+// best matches first.
+func (q ProfilesQ) SelectRanked(ctx context.Context, text string) ([]RankedProfile, error) {
+	ctx, done := observeQuery(ctx, profilesTable, "select_ranked")
+	defer done()
+
+	query, args, err := q.selector.
+		Column(sq.Expr(
+			"ts_rank(search_vector, plainto_tsquery('simple', ?)) + "+
+				"GREATEST(similarity(username, ?), similarity(coalesce(pseudonym, ''), ?)) AS score",
+			text, text, text,
+		)).
+		OrderBy("score DESC", "account_id ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building ranked select query for %s: %w", profilesTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []RankedProfile
+	for rows.Next() {
+		var score float64
+		p, err := scanProfile(rows, &score)
+		if err != nil {
+			return nil, fmt.Errorf("scanning ranked profile: %w", err)
+		}
+		out = append(out, RankedProfile{Profile: p, Score: score})
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
 func (q ProfilesQ) Delete(ctx context.Context) error {
+	ctx, done := observeQuery(ctx, profilesTable, "delete")
+	defer done()
+
 	query, args, err := q.deleter.ToSql()
 	if err != nil {
 		return fmt.Errorf("building delete query for %s: %w", profilesTable, err)
@@ -275,7 +355,7 @@ func (q ProfilesQ) FilterAccountID(accountID ...uuid.UUID) ProfilesQ {
 	return q
 }
 
-func (q ProfilesQ) FilterUsername(username string) ProfilesQ {
+func (q ProfilesQ) FilterUsername(username ...string) ProfilesQ {
 	q.selector = q.selector.Where(sq.Eq{"username": username})
 	q.counter = q.counter.Where(sq.Eq{"username": username})
 	q.deleter = q.deleter.Where(sq.Eq{"username": username})
@@ -291,25 +371,122 @@ func (q ProfilesQ) FilterOfficial(official bool) ProfilesQ {
 	return q
 }
 
+func (q ProfilesQ) FilterVersion(version int64) ProfilesQ {
+	q.selector = q.selector.Where(sq.Eq{"version": version})
+	q.counter = q.counter.Where(sq.Eq{"version": version})
+	q.deleter = q.deleter.Where(sq.Eq{"version": version})
+	q.updater = q.updater.Where(sq.Eq{"version": version})
+	return q
+}
+
+// FilterUsernameUpdatedBefore keeps profiles whose username was last changed before t, or at an unknown time.
+func (q ProfilesQ) FilterUsernameUpdatedBefore(t time.Time) ProfilesQ {
+	cond := sq.Or{sq.Eq{"username_updated_at": nil}, sq.Lt{"username_updated_at": t}}
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	return q
+}
+
+func (q ProfilesQ) FilterHidden(hidden bool) ProfilesQ {
+	q.selector = q.selector.Where(sq.Eq{"hidden": hidden})
+	q.counter = q.counter.Where(sq.Eq{"hidden": hidden})
+	q.deleter = q.deleter.Where(sq.Eq{"hidden": hidden})
+	q.updater = q.updater.Where(sq.Eq{"hidden": hidden})
+	return q
+}
+
 func (q ProfilesQ) FilterLikePseudonym(pseudonym string) ProfilesQ {
-	q.selector = q.selector.Where(sq.ILike{"pseudonym": "%" + pseudonym + "%"})
-	q.counter = q.counter.Where(sq.ILike{"pseudonym": "%" + pseudonym + "%"})
-	q.updater = q.updater.Where(sq.ILike{"pseudonym": "%" + pseudonym + "%"})
-	q.deleter = q.deleter.Where(sq.ILike{"pseudonym": "%" + pseudonym + "%"})
+	pattern := "%" + escapeLike(pseudonym) + "%"
+
+	q.selector = q.selector.Where(sq.ILike{"pseudonym": pattern})
+	q.counter = q.counter.Where(sq.ILike{"pseudonym": pattern})
+	q.updater = q.updater.Where(sq.ILike{"pseudonym": pattern})
+	q.deleter = q.deleter.Where(sq.ILike{"pseudonym": pattern})
 
 	return q
 }
 
 func (q ProfilesQ) FilterLikeUsername(username string) ProfilesQ {
-	q.selector = q.selector.Where(sq.ILike{"username": "%" + username + "%"})
-	q.counter = q.counter.Where(sq.ILike{"username": "%" + username + "%"})
-	q.updater = q.updater.Where(sq.ILike{"username": "%" + username + "%"})
-	q.deleter = q.deleter.Where(sq.ILike{"username": "%" + username + "%"})
+	pattern := "%" + escapeLike(username) + "%"
+
+	q.selector = q.selector.Where(sq.ILike{"username": pattern})
+	q.counter = q.counter.Where(sq.ILike{"username": pattern})
+	q.updater = q.updater.Where(sq.ILike{"username": pattern})
+	q.deleter = q.deleter.Where(sq.ILike{"username": pattern})
+
+	return q
+}
+
+func (q ProfilesQ) FilterCreatedAfter(t time.Time) ProfilesQ {
+	q.selector = q.selector.Where(sq.Gt{"created_at": t})
+	q.counter = q.counter.Where(sq.Gt{"created_at": t})
+	q.deleter = q.deleter.Where(sq.Gt{"created_at": t})
+	q.updater = q.updater.Where(sq.Gt{"created_at": t})
+	return q
+}
+
+func (q ProfilesQ) FilterCreatedBefore(t time.Time) ProfilesQ {
+	q.selector = q.selector.Where(sq.Lt{"created_at": t})
+	q.counter = q.counter.Where(sq.Lt{"created_at": t})
+	q.deleter = q.deleter.Where(sq.Lt{"created_at": t})
+	q.updater = q.updater.Where(sq.Lt{"created_at": t})
+	return q
+}
+
+func (q ProfilesQ) FilterUpdatedAfter(t time.Time) ProfilesQ {
+	q.selector = q.selector.Where(sq.Gt{"updated_at": t})
+	q.counter = q.counter.Where(sq.Gt{"updated_at": t})
+	q.deleter = q.deleter.Where(sq.Gt{"updated_at": t})
+	q.updater = q.updater.Where(sq.Gt{"updated_at": t})
+	return q
+}
+
+// FilterOlderThan keeps profiles placed after the given one in the newest-first listing order.
+func (q ProfilesQ) FilterOlderThan(createdAt time.Time, accountID uuid.UUID) ProfilesQ {
+	cond := sq.Expr("(created_at, account_id) < (?, ?)", createdAt, accountID)
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	return q
+}
 
+// FilterNewerThan keeps profiles placed before the given one in the newest-first listing order.
+func (q ProfilesQ) FilterNewerThan(createdAt time.Time, accountID uuid.UUID) ProfilesQ {
+	cond := sq.Expr("(created_at, account_id) > (?, ?)", createdAt, accountID)
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	return q
+}
+
+// FilterSearch keeps profiles whose username, pseudonym or description match the text
+// either as full-text words or as a fuzzy trigram match.
+func (q ProfilesQ) FilterSearch(text string) ProfilesQ {
+	cond := sq.Or{
+		sq.Expr("search_vector @@ plainto_tsquery('simple', ?)", text),
+		sq.Expr("username % ?", text),
+		sq.Expr("pseudonym % ?", text),
+		sq.Expr("description ILIKE ?", "%"+escapeLike(text)+"%"),
+	}
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
 	return q
 }
 
 func (q ProfilesQ) Count(ctx context.Context) (uint64, error) {
+	ctx, done := observeQuery(ctx, profilesTable, "count")
+	defer done()
+
 	query, args, err := q.counter.ToSql()
 	if err != nil {
 		return 0, fmt.Errorf("building count query for %s: %w", profilesTable, err)
@@ -342,6 +519,44 @@ func (q ProfilesQ) OrderCreatedAt(ascending bool) ProfilesQ {
 	return q
 }
 
+func (q ProfilesQ) OrderUpdatedAt(ascending bool) ProfilesQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("updated_at ASC")
+	} else {
+		q.selector = q.selector.OrderBy("updated_at DESC")
+	}
+	return q
+}
+
+func (q ProfilesQ) OrderUsername(ascending bool) ProfilesQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("username ASC")
+	} else {
+		q.selector = q.selector.OrderBy("username DESC")
+	}
+	return q
+}
+
+func (q ProfilesQ) OrderAccountID(ascending bool) ProfilesQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("account_id ASC")
+	} else {
+		q.selector = q.selector.OrderBy("account_id DESC")
+	}
+	return q
+}
+
+// OrderCreatedAtAccountID orders by creation time, using account_id as a tiebreaker,
+// so the order is total and stable between pages.
+func (q ProfilesQ) OrderCreatedAtAccountID(ascending bool) ProfilesQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("created_at ASC", "account_id ASC")
+	} else {
+		q.selector = q.selector.OrderBy("created_at DESC", "account_id DESC")
+	}
+	return q
+}
+
 func (q ProfilesQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
 	_, ok := TxFromCtx(ctx)
 	if ok {
@@ -373,3 +588,39 @@ func (q ProfilesQ) Transaction(ctx context.Context, fn func(ctx context.Context)
 
 	return nil
 }
+
+func scanProfile(row rowScanner, extra ...any) (Profile, error) {
+	var p Profile
+	dest := []any{
+		&p.AccountID,
+		&p.Username,
+		&p.UsernameUpdatedAt,
+		&p.Official,
+		&p.Pseudonym,
+		&p.Description,
+		&p.Avatar,
+		&p.Banner,
+		&p.AccentColor,
+		&p.Sex,
+		&p.BirthDate,
+		&p.Hidden,
+		&p.Version,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	}
+
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Profile{}, nil
+		}
+		return Profile{}, err
+	}
+
+	return p, nil
+}
+
+// escapeLike escapes LIKE wildcards in user input, so it is matched literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
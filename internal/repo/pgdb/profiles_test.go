@@ -0,0 +1,28 @@
+package pgdb
+
+import "testing"
+
+func TestEscapeLike(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "empty", in: "", want: ""},
+		{name: "plain", in: "alice", want: "alice"},
+		{name: "percent", in: "100%", want: `100\%`},
+		{name: "underscore", in: "john_doe", want: `john\_doe`},
+		{name: "backslash", in: `a\b`, want: `a\\b`},
+		{name: "escaped wildcard stays literal", in: `\%`, want: `\\\%`},
+		{name: "only wildcards", in: "%_%", want: `\%\_\%`},
+		{name: "unicode", in: "łódź_%", want: `łódź\_\%`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := escapeLike(tt.in); got != tt.want {
+				t.Fatalf("escapeLike(%q): expected %q, got %q", tt.in, tt.want, got)
+			}
+		})
+	}
+}
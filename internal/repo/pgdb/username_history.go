@@ -0,0 +1,119 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const usernameHistoryTable = "profile_username_history"
+
+const usernameHistoryColumns = "id, account_id, username, retired_at"
+
+type RetiredUsername struct {
+	ID        uuid.UUID `db:"id"`
+	AccountID uuid.UUID `db:"account_id"`
+	Username  string    `db:"username"`
+	RetiredAt time.Time `db:"retired_at"`
+}
+
+type UsernameHistoryQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+}
+
+func NewUsernameHistoryQ(db *sql.DB) UsernameHistoryQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return UsernameHistoryQ{
+		db:       db,
+		selector: builder.Select(usernameHistoryColumns).From(usernameHistoryTable),
+		inserter: builder.Insert(usernameHistoryTable),
+	}
+}
+
+func (q UsernameHistoryQ) New() UsernameHistoryQ {
+	return NewUsernameHistoryQ(q.db)
+}
+
+func (q UsernameHistoryQ) Insert(ctx context.Context, input RetiredUsername) (RetiredUsername, error) {
+	values := map[string]interface{}{
+		"account_id": input.AccountID,
+		"username":   input.Username,
+	}
+
+	query, args, err := q.inserter.
+		SetMap(values).
+		Suffix("RETURNING " + usernameHistoryColumns).
+		ToSql()
+	if err != nil {
+		return RetiredUsername{}, fmt.Errorf("building insert query for %s: %w", usernameHistoryTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	return scanRetiredUsername(row)
+}
+
+func (q UsernameHistoryQ) Get(ctx context.Context) (RetiredUsername, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return RetiredUsername{}, fmt.Errorf("building get query for %s: %w", usernameHistoryTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	return scanRetiredUsername(row)
+}
+
+func (q UsernameHistoryQ) FilterUsername(username string) UsernameHistoryQ {
+	q.selector = q.selector.Where(sq.Eq{"username": username})
+	return q
+}
+
+func (q UsernameHistoryQ) FilterRetiredAfter(t time.Time) UsernameHistoryQ {
+	q.selector = q.selector.Where(sq.Gt{"retired_at": t})
+	return q
+}
+
+func (q UsernameHistoryQ) OrderRetiredAt(ascending bool) UsernameHistoryQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("retired_at ASC")
+	} else {
+		q.selector = q.selector.OrderBy("retired_at DESC")
+	}
+	return q
+}
+
+func scanRetiredUsername(row rowScanner) (RetiredUsername, error) {
+	var u RetiredUsername
+	err := row.Scan(
+		&u.ID,
+		&u.AccountID,
+		&u.Username,
+		&u.RetiredAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return RetiredUsername{}, nil
+		}
+		return RetiredUsername{}, err
+	}
+
+	return u, nil
+}
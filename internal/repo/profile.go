@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/umisto/profiles-svc/internal/domain/entity"
@@ -11,10 +13,17 @@ import (
 	"github.com/umisto/profiles-svc/internal/repo/pgdb"
 )
 
-func (r *Repository) CreateProfile(ctx context.Context, userID uuid.UUID, username string) (entity.Profile, error) {
-	res, err := r.sql.profiles.New().Insert(ctx, pgdb.Profile{
-		AccountID: userID,
-		Username:  username,
+// CreateProfile inserts a profile unless the account already has one, in which case an empty profile is returned.
+func (r *Repository) CreateProfile(
+	ctx context.Context,
+	userID uuid.UUID,
+	username string,
+	usernameUpdatedAt *time.Time,
+) (entity.Profile, error) {
+	res, err := r.sql.profiles.New().OnConflictDoNothing().Insert(ctx, pgdb.Profile{
+		AccountID:         userID,
+		Username:          username,
+		UsernameUpdatedAt: usernameUpdatedAt,
 	})
 	if err != nil {
 		return entity.Profile{}, err
@@ -47,6 +56,34 @@ func (r *Repository) GetProfileByUsername(ctx context.Context, username string)
 	return row.ToEntity(), nil
 }
 
+func (r *Repository) GetProfilesByAccountIDs(ctx context.Context, ids []uuid.UUID) ([]entity.Profile, error) {
+	rows, err := r.sql.profiles.New().FilterHidden(false).FilterAccountID(ids...).Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	collection := make([]entity.Profile, 0, len(rows))
+	for _, row := range rows {
+		collection = append(collection, row.ToEntity())
+	}
+
+	return collection, nil
+}
+
+func (r *Repository) GetProfilesByUsernames(ctx context.Context, usernames []string) ([]entity.Profile, error) {
+	rows, err := r.sql.profiles.New().FilterHidden(false).FilterUsername(usernames...).Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	collection := make([]entity.Profile, 0, len(rows))
+	for _, row := range rows {
+		collection = append(collection, row.ToEntity())
+	}
+
+	return collection, nil
+}
+
 func (r *Repository) UpdateProfile(
 	ctx context.Context,
 	accountID uuid.UUID,
@@ -54,33 +91,60 @@ func (r *Repository) UpdateProfile(
 ) (entity.Profile, error) {
 	q := r.sql.profiles.New().FilterAccountID(accountID)
 
-	if input.Pseudonym != nil {
-		q = q.UpdatePseudonym(input.Pseudonym)
+	if input.Pseudonym.Set {
+		q = q.UpdatePseudonym(input.Pseudonym.Value)
+	}
+	if input.Description.Set {
+		q = q.UpdateDescription(input.Description.Value)
 	}
-	if input.Description != nil {
-		q = q.UpdateDescription(input.Description)
+	if input.Avatar.Set {
+		q = q.UpdateAvatar(input.Avatar.Value)
 	}
-	if input.Avatar != nil {
-		q = q.UpdateAvatar(input.Avatar)
+	if input.Banner.Set {
+		q = q.UpdateBanner(input.Banner.Value)
+	}
+	if input.AccentColor.Set {
+		q = q.UpdateAccentColor(input.AccentColor.Value)
+	}
+	if input.Sex.Set {
+		q = q.UpdateSex(input.Sex.Value)
+	}
+	if input.BirthDate.Set {
+		q = q.UpdateBirthDate(input.BirthDate.Value)
+	}
+	if input.Version != nil {
+		q = q.FilterVersion(*input.Version)
 	}
 
 	res, err := q.UpdateOne(ctx)
-	if err != nil {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return entity.Profile{}, nil
+	case err != nil:
 		return entity.Profile{}, err
 	}
 
 	return res.ToEntity(), nil
 }
 
+// UpdateProfileUsername changes the username unless a newer change has already been applied,
+// sql.ErrNoRows is returned when the profile is missing or the change is outdated.
 func (r *Repository) UpdateProfileUsername(
 	ctx context.Context,
 	accountID uuid.UUID,
 	username string,
+	usernameUpdatedAt *time.Time,
 ) (entity.Profile, error) {
-	res, err := r.sql.profiles.New().
+	q := r.sql.profiles.New().
 		FilterAccountID(accountID).
-		UpdateUsername(username).
-		UpdateOne(ctx)
+		UpdateUsername(username)
+
+	if usernameUpdatedAt != nil {
+		q = q.FilterUsernameUpdatedBefore(*usernameUpdatedAt).
+			UpdateUsernameUpdatedAt(usernameUpdatedAt)
+	}
+
+	res, err := q.UpdateOne(ctx)
 	if err != nil {
 		return entity.Profile{}, err
 	}
@@ -104,39 +168,174 @@ func (r *Repository) UpdateProfileOfficial(
 	return res.ToEntity(), nil
 }
 
+func (r *Repository) UpdateProfileHidden(
+	ctx context.Context,
+	accountID uuid.UUID,
+	hidden bool,
+) (entity.Profile, error) {
+	res, err := r.sql.profiles.New().
+		FilterAccountID(accountID).
+		UpdateHidden(hidden).
+		UpdateOne(ctx)
+	if err != nil {
+		return entity.Profile{}, err
+	}
+
+	return res.ToEntity(), nil
+}
+
 func (r *Repository) FilterProfilesByUsername(
 	ctx context.Context,
 	prefix string,
 	offset uint,
 	limit uint,
 ) (entity.ProfileCollection, error) {
-	rows, err := r.sql.profiles.New().
+	q := r.sql.profiles.New().
+		FilterHidden(false).
 		FilterLikeUsername(prefix).
-		Page(limit, offset).
-		Select(ctx)
+		OrderCreatedAtAccountID(false)
+
+	return r.selectProfilesPage(ctx, q, offset, limit)
+}
+
+func (r *Repository) FilterProfiles(
+	ctx context.Context,
+	params profile.FilterParams,
+	offset uint,
+	limit uint,
+) (entity.ProfileCollection, error) {
+	q := r.filterProfilesQ(params)
+
+	sort := profile.FilterSort{Field: profile.SortByCreatedAt}
+	if params.Sort != nil {
+		sort = *params.Sort
+	}
+
+	switch sort.Field {
+	case profile.SortByUpdatedAt:
+		q = q.OrderUpdatedAt(sort.Ascending).OrderAccountID(sort.Ascending)
+	case profile.SortByUsername:
+		q = q.OrderUsername(sort.Ascending)
+	default:
+		q = q.OrderCreatedAtAccountID(sort.Ascending)
+	}
+
+	return r.selectProfilesPage(ctx, q, offset, limit)
+}
+
+// FilterProfilesByCursor selects one page of profiles in keyset mode, starting right after
+// (or, for backward cursors, right before) the profile the cursor points at.
+func (r *Repository) FilterProfilesByCursor(
+	ctx context.Context,
+	params profile.FilterParams,
+	cursor *entity.ProfileCursor,
+	limit uint,
+) (entity.ProfileCollection, error) {
+	q := r.filterProfilesQ(params)
+
+	total, err := q.Count(ctx)
 	if err != nil {
 		return entity.ProfileCollection{}, err
 	}
 
-	collection := make([]entity.Profile, 0, len(rows))
+	backward := cursor != nil && cursor.Backward
+	switch {
+	case cursor == nil:
+	case backward:
+		q = q.FilterNewerThan(cursor.CreatedAt, cursor.AccountID)
+	default:
+		q = q.FilterOlderThan(cursor.CreatedAt, cursor.AccountID)
+	}
+
+	// one extra row tells whether there is anything beyond this page
+	rows, err := q.OrderCreatedAtAccountID(backward).Page(limit+1, 0).Select(ctx)
+	if err != nil {
+		return entity.ProfileCollection{}, err
+	}
+
+	profiles := make([]entity.Profile, 0, len(rows))
 	for _, row := range rows {
-		collection = append(collection, row.ToEntity())
+		profiles = append(profiles, row.ToEntity())
 	}
 
-	return entity.ProfileCollection{
-		Data: collection,
-		Page: uint(offset/limit) + 1,
-		Size: uint(len(collection)),
-	}, nil
+	res := cursorPage(profiles, cursor, limit)
+	res.Total = uint(total)
+
+	return res, nil
 }
 
-func (r *Repository) FilterProfiles(
+// cursorPage turns up to limit+1 profiles, selected in the direction of the cursor starting next
+// to it, into a page in listing order with cursors to its neighbours.
+func cursorPage(profiles []entity.Profile, cursor *entity.ProfileCursor, limit uint) entity.ProfileCollection {
+	backward := cursor != nil && cursor.Backward
+
+	hasMore := uint(len(profiles)) > limit
+	if hasMore {
+		profiles = profiles[:limit]
+	}
+	if backward {
+		slices.Reverse(profiles)
+	}
+
+	res := entity.ProfileCollection{
+		Data: profiles,
+		Size: limit,
+	}
+	if len(profiles) == 0 {
+		return res
+	}
+
+	first := profiles[0]
+	last := profiles[len(profiles)-1]
+
+	if (backward && hasMore) || (!backward && cursor != nil) {
+		res.PrevCursor = &entity.ProfileCursor{CreatedAt: first.CreatedAt, AccountID: first.AccountID, Backward: true}
+	}
+	if backward || hasMore {
+		res.NextCursor = &entity.ProfileCursor{CreatedAt: last.CreatedAt, AccountID: last.AccountID}
+	}
+
+	return res
+}
+
+func (r *Repository) SearchProfiles(
 	ctx context.Context,
-	params profile.FilterParams,
+	query string,
 	offset uint,
 	limit uint,
-) (entity.ProfileCollection, error) {
-	q := r.sql.profiles.New()
+) (entity.ProfileSearchCollection, error) {
+	q := r.sql.profiles.New().
+		FilterHidden(false).
+		FilterSearch(query)
+
+	rows, err := q.Page(limit, offset).SelectRanked(ctx, query)
+	if err != nil {
+		return entity.ProfileSearchCollection{}, err
+	}
+
+	total, err := q.Count(ctx)
+	if err != nil {
+		return entity.ProfileSearchCollection{}, err
+	}
+
+	collection := make([]entity.ProfileSearchResult, 0, len(rows))
+	for _, row := range rows {
+		collection = append(collection, entity.ProfileSearchResult{
+			Profile: row.ToEntity(),
+			Score:   row.Score,
+		})
+	}
+
+	return entity.ProfileSearchCollection{
+		Data:   collection,
+		Offset: offset,
+		Size:   limit,
+		Total:  uint(total),
+	}, nil
+}
+
+func (r *Repository) filterProfilesQ(params profile.FilterParams) pgdb.ProfilesQ {
+	q := r.sql.profiles.New().FilterHidden(false)
 
 	if params.PseudonymPrefix != nil {
 		q = q.FilterLikePseudonym(*params.PseudonymPrefix)
@@ -144,21 +343,49 @@ func (r *Repository) FilterProfiles(
 	if params.UsernamePrefix != nil {
 		q = q.FilterLikeUsername(*params.UsernamePrefix)
 	}
+	if params.Official != nil {
+		q = q.FilterOfficial(*params.Official)
+	}
+	if params.CreatedAfter != nil {
+		q = q.FilterCreatedAfter(*params.CreatedAfter)
+	}
+	if params.CreatedBefore != nil {
+		q = q.FilterCreatedBefore(*params.CreatedBefore)
+	}
+	if params.UpdatedAfter != nil {
+		q = q.FilterUpdatedAfter(*params.UpdatedAfter)
+	}
+
+	return q
+}
 
+// selectProfilesPage selects one page of profiles matching q together with the total number of matches.
+func (r *Repository) selectProfilesPage(
+	ctx context.Context,
+	q pgdb.ProfilesQ,
+	offset uint,
+	limit uint,
+) (entity.ProfileCollection, error) {
 	rows, err := q.Page(limit, offset).Select(ctx)
 	if err != nil {
 		return entity.ProfileCollection{}, err
 	}
 
+	total, err := q.Count(ctx)
+	if err != nil {
+		return entity.ProfileCollection{}, err
+	}
+
 	collection := make([]entity.Profile, 0, len(rows))
 	for _, row := range rows {
 		collection = append(collection, row.ToEntity())
 	}
 
 	return entity.ProfileCollection{
-		Data: collection,
-		Page: uint(offset/limit) + 1,
-		Size: uint(len(collection)),
+		Data:   collection,
+		Offset: offset,
+		Size:   limit,
+		Total:  uint(total),
 	}, nil
 }
 
@@ -0,0 +1,48 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/repo/pgdb"
+)
+
+// ResetProfile clears pseudonym, description, avatar and banner, replacing the username when one is given.
+func (r *Repository) ResetProfile(
+	ctx context.Context,
+	accountID uuid.UUID,
+	username *string,
+) (entity.Profile, error) {
+	q := r.sql.profiles.New().
+		FilterAccountID(accountID).
+		UpdatePseudonym(nil).
+		UpdateDescription(nil).
+		UpdateAvatar(nil).
+		UpdateBanner(nil)
+
+	if username != nil {
+		q = q.UpdateUsername(*username)
+	}
+
+	res, err := q.UpdateOne(ctx)
+	if err != nil {
+		return entity.Profile{}, err
+	}
+
+	return res.ToEntity(), nil
+}
+
+func (r *Repository) CreateProfileReset(ctx context.Context, input entity.ProfileReset) (entity.ProfileReset, error) {
+	res, err := r.sql.resets.New().Insert(ctx, pgdb.ProfileReset{
+		AccountID:     input.AccountID,
+		InitiatorID:   input.InitiatorID,
+		Reason:        input.Reason,
+		UsernameReset: input.UsernameReset,
+	})
+	if err != nil {
+		return entity.ProfileReset{}, err
+	}
+
+	return res.ToEntity(), nil
+}
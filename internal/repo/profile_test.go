@@ -0,0 +1,113 @@
+package repo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+)
+
+func TestCursorPage(t *testing.T) {
+	// listing order is newest first: p[4], p[3], p[2], p[1], p[0]
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := make([]entity.Profile, 5)
+	for i := range p {
+		p[i] = entity.Profile{AccountID: uuid.New(), CreatedAt: base.Add(time.Duration(i) * time.Hour)}
+	}
+
+	forward := func(pr entity.Profile) *entity.ProfileCursor {
+		return &entity.ProfileCursor{CreatedAt: pr.CreatedAt, AccountID: pr.AccountID}
+	}
+	backward := func(pr entity.Profile) *entity.ProfileCursor {
+		return &entity.ProfileCursor{CreatedAt: pr.CreatedAt, AccountID: pr.AccountID, Backward: true}
+	}
+
+	tests := []struct {
+		name   string
+		cursor *entity.ProfileCursor
+		// rows as selected: newest first after a forward cursor, oldest first before a backward one
+		rows     []entity.Profile
+		wantData []entity.Profile
+		wantPrev *entity.ProfileCursor
+		wantNext *entity.ProfileCursor
+	}{
+		{
+			name:     "first page",
+			rows:     []entity.Profile{p[4], p[3], p[2]},
+			wantData: []entity.Profile{p[4], p[3]},
+			wantNext: forward(p[3]),
+		},
+		{
+			name:     "first page is the only one",
+			rows:     []entity.Profile{p[4], p[3]},
+			wantData: []entity.Profile{p[4], p[3]},
+		},
+		{
+			name:     "forward in the middle",
+			cursor:   forward(p[3]),
+			rows:     []entity.Profile{p[2], p[1], p[0]},
+			wantData: []entity.Profile{p[2], p[1]},
+			wantPrev: backward(p[2]),
+			wantNext: forward(p[1]),
+		},
+		{
+			name:     "forward to the last page",
+			cursor:   forward(p[1]),
+			rows:     []entity.Profile{p[0]},
+			wantData: []entity.Profile{p[0]},
+			wantPrev: backward(p[0]),
+		},
+		{
+			name:     "backward in the middle is reversed",
+			cursor:   backward(p[0]),
+			rows:     []entity.Profile{p[1], p[2], p[3]},
+			wantData: []entity.Profile{p[2], p[1]},
+			wantPrev: backward(p[2]),
+			wantNext: forward(p[1]),
+		},
+		{
+			name:     "backward to the first page",
+			cursor:   backward(p[2]),
+			rows:     []entity.Profile{p[3], p[4]},
+			wantData: []entity.Profile{p[4], p[3]},
+			wantNext: forward(p[3]),
+		},
+		{
+			name:   "nothing beyond the cursor",
+			cursor: forward(p[0]),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := cursorPage(tt.rows, tt.cursor, 2)
+
+			if res.Size != 2 {
+				t.Fatalf("expected size 2, got %d", res.Size)
+			}
+			if len(res.Data) != len(tt.wantData) {
+				t.Fatalf("expected %d profiles, got %d", len(tt.wantData), len(res.Data))
+			}
+			for i := range tt.wantData {
+				if res.Data[i].AccountID != tt.wantData[i].AccountID {
+					t.Fatalf("profile %d: expected %s, got %s", i, tt.wantData[i].AccountID, res.Data[i].AccountID)
+				}
+			}
+			assertCursor(t, "prev", tt.wantPrev, res.PrevCursor)
+			assertCursor(t, "next", tt.wantNext, res.NextCursor)
+		})
+	}
+}
+
+func assertCursor(t *testing.T, name string, want, got *entity.ProfileCursor) {
+	t.Helper()
+
+	switch {
+	case want == nil && got == nil:
+	case want == nil || got == nil:
+		t.Fatalf("%s cursor: expected %+v, got %+v", name, want, got)
+	case *want != *got:
+		t.Fatalf("%s cursor: expected %+v, got %+v", name, *want, *got)
+	}
+}
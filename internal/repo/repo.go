@@ -1,6 +1,7 @@
 package repo
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/umisto/profiles-svc/internal/repo/pgdb"
@@ -12,12 +13,24 @@ type Repository struct {
 
 type SqlDB struct {
 	profiles pgdb.ProfilesQ
+	outbox   pgdb.OutboxEventsQ
+	resets   pgdb.ProfileResetsQ
+	history  pgdb.UsernameHistoryQ
+	inbox    pgdb.InboxEventsQ
 }
 
 func New(db *sql.DB) *Repository {
 	return &Repository{
 		sql: SqlDB{
 			profiles: pgdb.NewProfilesQ(db),
+			outbox:   pgdb.NewOutboxEventsQ(db),
+			resets:   pgdb.NewProfileResetsQ(db),
+			history:  pgdb.NewUsernameHistoryQ(db),
+			inbox:    pgdb.NewInboxEventsQ(db),
 		},
 	}
 }
+
+func (r *Repository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.sql.profiles.Transaction(ctx, fn)
+}
@@ -0,0 +1,36 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/repo/pgdb"
+)
+
+func (r *Repository) CreateRetiredUsername(ctx context.Context, accountID uuid.UUID, username string) (entity.RetiredUsername, error) {
+	res, err := r.sql.history.New().Insert(ctx, pgdb.RetiredUsername{
+		AccountID: accountID,
+		Username:  username,
+	})
+	if err != nil {
+		return entity.RetiredUsername{}, err
+	}
+
+	return res.ToEntity(), nil
+}
+
+// GetRetiredUsername returns the latest retirement of the username after the given time, if any.
+func (r *Repository) GetRetiredUsername(ctx context.Context, username string, after time.Time) (entity.RetiredUsername, error) {
+	res, err := r.sql.history.New().
+		FilterUsername(username).
+		FilterRetiredAfter(after).
+		OrderRetiredAt(false).
+		Get(ctx)
+	if err != nil {
+		return entity.RetiredUsername{}, err
+	}
+
+	return res.ToEntity(), nil
+}
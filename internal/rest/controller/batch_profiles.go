@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/rest/requests"
+	"github.com/umisto/profiles-svc/internal/rest/responses"
+)
+
+func (s Service) BatchProfiles(w http.ResponseWriter, r *http.Request) {
+	req, err := requests.BatchProfiles(r)
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid batch profiles request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	res, err := s.domain.GetProfilesBatch(r.Context(), req.Data.Attributes.AccountIds, req.Data.Attributes.Usernames)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to get profiles batch")
+		switch {
+		case errors.Is(err, errx.ErrorBatchTooLarge):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes": fmt.Errorf("too many identifiers, %s", err),
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.ProfilesBatch(res))
+}
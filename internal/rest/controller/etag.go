@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/jsonapi"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+)
+
+// profileETag is a strong entity tag of the profile representation, it changes with every update.
+func profileETag(p entity.Profile) string {
+	return fmt.Sprintf(`"%d"`, p.Version)
+}
+
+// notModified sets the profile ETag on the response and reports whether the client's
+// If-None-Match already holds it, in which case 304 should be sent instead of the body.
+func notModified(w http.ResponseWriter, r *http.Request, p entity.Profile) bool {
+	etag := profileETag(p)
+	w.Header().Set("ETag", etag)
+
+	return noneMatch(r, etag)
+}
+
+// noneMatch reports whether the client's If-None-Match holds the entity tag.
+func noneMatch(r *http.Request, etag string) bool {
+	header := r.Header.Get("If-None-Match")
+	if header == "" {
+		return false
+	}
+
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ifMatchVersion reads the profile version expected by the If-Match header,
+// nil means the header is absent or '*' and any version is accepted.
+func ifMatchVersion(r *http.Request) (*int64, error) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	tag, ok := strings.CutPrefix(header, `"`)
+	if ok {
+		tag, ok = strings.CutSuffix(tag, `"`)
+	}
+	if !ok {
+		return nil, fmt.Errorf("If-Match must hold a single strong entity tag, got %s", header)
+	}
+
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("If-Match holds an unknown entity tag %s", header)
+	}
+
+	return &version, nil
+}
+
+func preconditionFailed(detail string) *jsonapi.ErrorObject {
+	return &jsonapi.ErrorObject{
+		Title:  http.StatusText(http.StatusPreconditionFailed),
+		Status: strconv.Itoa(http.StatusPreconditionFailed),
+		Detail: detail,
+	}
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+)
+
+func TestIfMatchVersion(t *testing.T) {
+	version := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name    string
+		header  string
+		want    *int64
+		wantErr bool
+	}{
+		{name: "absent"},
+		{name: "any", header: "*"},
+		{name: "any with spaces", header: " * "},
+		{name: "strong", header: `"7"`, want: version(7)},
+		{name: "strong with spaces", header: ` "7" `, want: version(7)},
+		{name: "zero", header: `"0"`, want: version(0)},
+		{name: "weak", header: `W/"7"`, wantErr: true},
+		{name: "list", header: `"7", "8"`, wantErr: true},
+		{name: "any in a list", header: `*, "7"`, wantErr: true},
+		{name: "unquoted", header: "7", wantErr: true},
+		{name: "unterminated", header: `"7`, wantErr: true},
+		{name: "lone quote", header: `"`, wantErr: true},
+		{name: "empty tag", header: `""`, wantErr: true},
+		{name: "not a version", header: `"abc"`, wantErr: true},
+		{name: "overflow", header: `"9223372036854775808"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("PUT", "/profiles-svc/v1/profiles/me", nil)
+			if tt.header != "" {
+				r.Header.Set("If-Match", tt.header)
+			}
+
+			got, err := ifMatchVersion(r)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("If-Match %s: expected an error, got version %v", tt.header, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("If-Match %s: unexpected error: %v", tt.header, err)
+			}
+
+			switch {
+			case tt.want == nil && got == nil:
+			case tt.want == nil || got == nil:
+				t.Fatalf("If-Match %s: expected %v, got %v", tt.header, tt.want, got)
+			case *tt.want != *got:
+				t.Fatalf("If-Match %s: expected %d, got %d", tt.header, *tt.want, *got)
+			}
+		})
+	}
+}
+
+func TestNotModified(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   bool
+	}{
+		{name: "absent"},
+		{name: "same", header: `"7"`, want: true},
+		{name: "other", header: `"8"`},
+		{name: "weak matches", header: `W/"7"`, want: true},
+		{name: "any", header: "*", want: true},
+		{name: "in a list", header: `"5", W/"7"`, want: true},
+		{name: "not in a list", header: `"5", "6"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/profiles-svc/v1/profiles/me", nil)
+			if tt.header != "" {
+				r.Header.Set("If-None-Match", tt.header)
+			}
+			w := httptest.NewRecorder()
+
+			if got := notModified(w, r, entity.Profile{Version: 7}); got != tt.want {
+				t.Fatalf("If-None-Match %s: expected %t, got %t", tt.header, tt.want, got)
+			}
+			if etag := w.Header().Get("ETag"); etag != `"7"` {
+				t.Fatalf("If-None-Match %s: expected ETag %q, got %q", tt.header, `"7"`, etag)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/profiles-svc/internal/rest/requests"
+	"github.com/umisto/profiles-svc/internal/rest/responses"
+	"github.com/umisto/restkit/pagi"
+)
+
+func (s Service) FilterInboxEvents(w http.ResponseWriter, r *http.Request) {
+	pag, size := pagi.GetPagination(r)
+
+	filters, err := requests.FilterInboxEvents(r)
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid filter inbox events request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
+	res, err := s.inbox.FilterEvents(r.Context(), filters, pag, size)
+	if err != nil {
+		s.log.WithError(err).Error("failed to filter inbox events")
+		ape.RenderErr(w, problems.InternalError())
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.InboxEventsCollection(r, res))
+}
@@ -1,36 +1,65 @@
 package controller
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
-	"strings"
 
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/umisto/ape"
 	"github.com/umisto/ape/problems"
-	"github.com/umisto/profiles-svc/internal/domain/modules/profile"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/rest/requests"
 	"github.com/umisto/profiles-svc/internal/rest/responses"
 	"github.com/umisto/restkit/pagi"
 )
 
 func (s Service) FilterProfiles(w http.ResponseWriter, r *http.Request) {
-	q := r.URL.Query()
 	pag, size := pagi.GetPagination(r)
 
-	filters := profile.FilterParams{}
-
-	if usernameLike := strings.TrimSpace(q.Get("username_like")); usernameLike != "" {
-		filters.UsernamePrefix = &usernameLike
+	filters, err := requests.FilterProfiles(r)
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid filter profiles request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
 	}
 
-	if pseudonym := strings.TrimSpace(q.Get("pseudonym")); pseudonym != "" {
-		filters.PseudonymPrefix = &pseudonym
+	if requests.CursorMode(r) {
+		cursor, err := requests.ProfileCursor(r)
+		if err != nil {
+			s.log.WithError(err).Errorf("invalid profiles cursor")
+			ape.RenderErr(w, problems.BadRequest(err)...)
+			return
+		}
+
+		res, err := s.domain.FilterProfileByCursor(r.Context(), filters, cursor, size)
+		if err != nil {
+			s.log.WithError(err).Error("failed to filter profiles by cursor")
+			s.renderFilterErr(w, err)
+			return
+		}
+
+		ape.Render(w, http.StatusOK, responses.ProfileCursorCollection(r, res))
+		return
 	}
 
 	res, err := s.domain.FilterProfile(r.Context(), filters, pag, size)
 	if err != nil {
 		s.log.WithError(err).Error("failed to filter profiles")
-		ape.RenderErr(w, problems.InternalError())
+		s.renderFilterErr(w, err)
 		return
 	}
 
-	ape.Render(w, http.StatusOK, responses.ProfileCollection(res))
+	ape.Render(w, http.StatusOK, responses.ProfileCollection(r, res))
+}
+
+func (s Service) renderFilterErr(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, errx.ErrorSortIsNotValid):
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query/sort": fmt.Errorf("sort is not valid, %s", err),
+		})...)
+	default:
+		ape.RenderErr(w, problems.InternalError())
+	}
 }
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/rest/requests"
+)
+
+// defaultAvatarMaxAge is how long clients may cache a default avatar, initials follow the username.
+const defaultAvatarMaxAge = 24 * 60 * 60
+
+func (s Service) GetDefaultAvatar(w http.ResponseWriter, r *http.Request) {
+	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid user id")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"path/user_id": fmt.Errorf("invalid user id: %s", chi.URLParam(r, "user_id")),
+		})...)
+
+		return
+	}
+
+	params, err := requests.DefaultAvatar(r)
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid default avatar request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	res, err := s.domain.GetProfileByID(r.Context(), userID)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to get profile by user id")
+		switch {
+		case errors.Is(err, errx.ErrorProfileNotFound):
+			ape.RenderErr(w, problems.NotFound("profile for user does not exist"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	if res.Hidden {
+		ape.RenderErr(w, problems.NotFound("profile for user does not exist"))
+
+		return
+	}
+
+	avatar, err := s.domain.GenerateDefaultAvatar(r.Context(), res, params)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to generate default avatar")
+		switch {
+		case errors.Is(err, errx.ErrorDefaultAvatarIsNotValid):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"query": fmt.Errorf("default avatar parameters are invalid, %s", err),
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	etag := fmt.Sprintf(`"%x"`, sha256.Sum256(avatar.Data))
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(defaultAvatarMaxAge))
+
+	if noneMatch(r, etag) {
+		w.WriteHeader(http.StatusNotModified)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", avatar.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(avatar.Data)))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if avatar.ContentType == "image/svg+xml" {
+		// the document is generated, still nothing in it may run when opened directly
+		w.Header().Set("Content-Security-Policy", "default-src 'none'; sandbox")
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(avatar.Data)
+}
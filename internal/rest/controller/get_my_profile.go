@@ -33,5 +33,11 @@ func (s Service) GetMyProfile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if notModified(w, r, res) {
+		w.WriteHeader(http.StatusNotModified)
+
+		return
+	}
+
 	ape.Render(w, http.StatusOK, responses.Profile(res))
 }
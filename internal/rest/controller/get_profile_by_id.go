@@ -38,5 +38,17 @@ func (s Service) GetProfileByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if res.Hidden {
+		ape.RenderErr(w, problems.NotFound("profile for user does not exist"))
+
+		return
+	}
+
+	if notModified(w, r, res) {
+		w.WriteHeader(http.StatusNotModified)
+
+		return
+	}
+
 	ape.Render(w, http.StatusOK, responses.Profile(res))
 }
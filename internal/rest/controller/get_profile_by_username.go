@@ -3,12 +3,15 @@ package controller
 import (
 	"errors"
 	"net/http"
+	"net/url"
+	"path"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/umisto/ape"
 	"github.com/umisto/ape/problems"
 	"github.com/umisto/profiles-svc/internal/domain/errx"
 	"github.com/umisto/profiles-svc/internal/rest/responses"
+	"github.com/umisto/profiles-svc/resources"
 )
 
 func (s Service) GetProfileByUsername(w http.ResponseWriter, r *http.Request) {
@@ -27,5 +30,30 @@ func (s Service) GetProfileByUsername(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ape.Render(w, http.StatusOK, responses.Profile(res))
+	if res.Hidden {
+		ape.RenderErr(w, problems.NotFound("profile for user does not exist"))
+
+		return
+	}
+
+	if notModified(w, r, res) {
+		w.WriteHeader(http.StatusNotModified)
+
+		return
+	}
+
+	resp := responses.Profile(res)
+
+	// the username was retired, point the client at the canonical location like a 301 would
+	if res.Username != username {
+		location := path.Join(path.Dir(r.URL.Path), url.PathEscape(res.Username))
+		w.Header().Set("Content-Location", location)
+
+		resp.Meta = &resources.ProfileMeta{
+			RedirectedFrom: &username,
+			Location:       &location,
+		}
+	}
+
+	ape.Render(w, http.StatusOK, resp)
 }
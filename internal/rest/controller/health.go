@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/profiles-svc/internal/health"
+)
+
+// Healthz only tells that the process is up and serving requests.
+func (s Service) Healthz(w http.ResponseWriter, r *http.Request) {
+	ape.Render(w, http.StatusOK, map[string]string{"status": health.StatusUp})
+}
+
+// Readyz reports every dependency, with 503 if any of them is down.
+func (s Service) Readyz(w http.ResponseWriter, r *http.Request) {
+	report := s.health.Check(r.Context())
+
+	status := http.StatusOK
+	if report.Status != health.StatusUp {
+		s.log.Warnf("service is not ready: %+v", report.Checks)
+		status = http.StatusServiceUnavailable
+	}
+
+	ape.Render(w, status, report)
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/umisto/logium"
+	"github.com/umisto/profiles-svc/internal/health"
+)
+
+type fakeHealth struct {
+	report health.Report
+}
+
+func (f fakeHealth) Check(_ context.Context) health.Report {
+	return f.report
+}
+
+func TestReadyz(t *testing.T) {
+	up := health.Check{Status: health.StatusUp}
+	down := health.Check{Status: health.StatusDown, Error: "dial tcp: connection refused"}
+
+	tests := []struct {
+		name       string
+		report     health.Report
+		wantStatus int
+	}{
+		{
+			name: "every dependency is up",
+			report: health.Report{Status: health.StatusUp, Checks: map[string]health.Check{
+				health.CheckPostgres: up,
+				health.CheckKafka:    up,
+			}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "a dependency is down",
+			report: health.Report{Status: health.StatusDown, Checks: map[string]health.Check{
+				health.CheckPostgres: up,
+				health.CheckKafka:    down,
+			}},
+			wantStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(logium.NewLogger("debug", "text"), nil, nil, fakeHealth{report: tt.report})
+
+			w := httptest.NewRecorder()
+			s.Readyz(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+
+			var got health.Report
+			if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+				t.Fatalf("decoding report: %v", err)
+			}
+			if got.Status != tt.report.Status || len(got.Checks) != len(tt.report.Checks) {
+				t.Fatalf("expected report %+v, got %+v", tt.report, got)
+			}
+			if kafka := got.Checks[health.CheckKafka]; kafka.Status != tt.report.Checks[health.CheckKafka].Status {
+				t.Fatalf("expected kafka to be %s, got %+v", tt.report.Checks[health.CheckKafka].Status, kafka)
+			}
+		})
+	}
+}
@@ -1,32 +1,51 @@
 package controller
 
 import (
+	"errors"
 	"net/http"
+
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/domain/modules/profile"
+	"github.com/umisto/profiles-svc/internal/rest/meta"
+	"github.com/umisto/profiles-svc/internal/rest/requests"
+	"github.com/umisto/profiles-svc/internal/rest/responses"
 )
 
 func (s Service) ResetProfile(w http.ResponseWriter, r *http.Request) {
-	//userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
-	//if err != nil {
-	//	s.log.WithError(err).Errorf("invalid user id")
-	//	ape.RenderErr(w, problems.BadRequest(validation.Errors{
-	//		"query": fmt.Errorf("invalid user id: %s", chi.URLParam(r, "user_id")),
-	//	})...)
-	//
-	//	return
-	//}
-	//
-	//req, err := s.domain.Profile.ResetProfile(r.Context(), userID)
-	//if err != nil {
-	//	s.log.WithError(err).Errorf("failed to reset username")
-	//	switch {
-	//	case errors.Is(err, errx.ErrorProfileNotFound):
-	//		ape.RenderErr(w, problems.NotFound("profile for user does not exist"))
-	//	default:
-	//		ape.RenderErr(w, problems.InternalError())
-	//	}
-	//
-	//	return
-	//}
-	//
-	//ape.Render(w, http.StatusOK, responses.Profile(req))
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get account from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get account from context"))
+
+		return
+	}
+
+	req, err := requests.ResetProfile(r)
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid reset profile request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	res, err := s.domain.ResetProfile(r.Context(), req.Data.Id, profile.ResetParams{
+		InitiatorID:   initiator.ID,
+		Reason:        req.Data.Attributes.Reason,
+		ResetUsername: req.Data.Attributes.GetResetUsername(),
+	})
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to reset profile")
+		switch {
+		case errors.Is(err, errx.ErrorProfileNotFound):
+			ape.RenderErr(w, problems.NotFound("profile for user does not exist"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.Profile(res))
 }
@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/rest/responses"
+	"github.com/umisto/restkit/pagi"
+)
+
+func (s Service) SearchProfiles(w http.ResponseWriter, r *http.Request) {
+	pag, size := pagi.GetPagination(r)
+
+	res, err := s.domain.SearchProfiles(r.Context(), r.URL.Query().Get("q"), pag, size)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to search profiles")
+		switch {
+		case errors.Is(err, errx.ErrorSearchQueryIsNotValid):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"query/q": fmt.Errorf("search query is not valid, %s", err),
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.ProfileSearchCollection(r, res))
+}
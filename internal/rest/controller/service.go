@@ -4,32 +4,54 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/umisto/kafkakit/box"
 	"github.com/umisto/logium"
 	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/domain/modules/inbox"
 	"github.com/umisto/profiles-svc/internal/domain/modules/profile"
+	"github.com/umisto/profiles-svc/internal/health"
 )
 
 type Domain interface {
-	CreateProfile(ctx context.Context, userID uuid.UUID, username string) (entity.Profile, error)
-
 	FilterProfile(ctx context.Context, params profile.FilterParams, offset, limit int32) (entity.ProfileCollection, error)
+	SearchProfiles(ctx context.Context, query string, offset, limit int32) (entity.ProfileSearchCollection, error)
+	FilterProfileByCursor(ctx context.Context, params profile.FilterParams, cursor *entity.ProfileCursor, limit int32) (entity.ProfileCollection, error)
 
 	GetProfileByID(ctx context.Context, userID uuid.UUID) (entity.Profile, error)
 	GetProfileByUsername(ctx context.Context, username string) (entity.Profile, error)
+	GetProfilesBatch(ctx context.Context, ids []uuid.UUID, usernames []string) (entity.ProfileBatch, error)
+	GenerateDefaultAvatar(ctx context.Context, p entity.Profile, params profile.DefaultAvatarParams) (profile.DefaultAvatar, error)
 
 	UpdateProfile(ctx context.Context, accountID uuid.UUID, input profile.UpdateParams) (entity.Profile, error)
 	UpdateProfileOfficial(ctx context.Context, accountID uuid.UUID, official bool) (entity.Profile, error)
-	UpdateProfileUsername(ctx context.Context, accountID uuid.UUID, username string) (entity.Profile, error)
+	UpdateProfileAvatar(ctx context.Context, accountID uuid.UUID, params profile.ImageParams) (entity.Profile, error)
+
+	ResetProfile(ctx context.Context, accountID uuid.UUID, params profile.ResetParams) (entity.Profile, error)
+}
+
+type Inbox interface {
+	FilterEvents(ctx context.Context, params inbox.FilterParams, offset, limit int32) (entity.InboxEventCollection, error)
+	GetEvent(ctx context.Context, id uuid.UUID) (box.InboxEvent, error)
+	RequeueEvent(ctx context.Context, id uuid.UUID) (box.InboxEvent, error)
+	SkipEvent(ctx context.Context, id uuid.UUID) (box.InboxEvent, error)
+}
+
+type Health interface {
+	Check(ctx context.Context) health.Report
 }
 
 type Service struct {
 	domain Domain
+	inbox  Inbox
+	health Health
 	log    logium.Logger
 }
 
-func New(log logium.Logger, profile Domain) Service {
+func New(log logium.Logger, profile Domain, inbox Inbox, health Health) Service {
 	return Service{
 		domain: profile,
+		inbox:  inbox,
+		health: health,
 		log:    log,
 	}
 }
@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/rest/responses"
+)
+
+func (s Service) SkipInboxEvent(w http.ResponseWriter, r *http.Request) {
+	eventID, err := uuid.Parse(chi.URLParam(r, "event_id"))
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid inbox event id")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("invalid inbox event id: %s", chi.URLParam(r, "event_id")),
+		})...)
+
+		return
+	}
+
+	res, err := s.inbox.SkipEvent(r.Context(), eventID)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to skip inbox event")
+		switch {
+		case errors.Is(err, errx.ErrorInboxEventNotFound):
+			ape.RenderErr(w, problems.NotFound("inbox event does not exist"))
+		case errors.Is(err, errx.ErrorInboxEventStatusConflict):
+			ape.RenderErr(w, problems.Conflict("inbox event status does not allow this action"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.InboxEvent(res))
+}
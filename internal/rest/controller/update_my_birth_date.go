@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/domain/modules/profile"
+	"github.com/umisto/profiles-svc/internal/rest/meta"
+	"github.com/umisto/profiles-svc/internal/rest/requests"
+	"github.com/umisto/profiles-svc/internal/rest/responses"
+)
+
+func (s Service) UpdateMyBirthDate(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	req, err := requests.UpdateBirthDate(r)
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid update birth date request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	if req.Data.Id != initiator.ID {
+		s.log.Errorf("id in body %s and initiator id %s mismatch for update birth date request", req.Data.Id, initiator.ID)
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"data/id": fmt.Errorf("id in body: %s and initiator id: %s mismatch", req.Data.Id, initiator.ID),
+		})...)
+
+		return
+	}
+
+	birthDate, err := time.Parse(time.DateOnly, req.Data.Attributes.BirthDate)
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid birth date in update birth date request")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"data/attributes/birth_date": fmt.Errorf("birth date format is invalid: %w", err),
+		})...)
+
+		return
+	}
+
+	version, err := ifMatchVersion(r)
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid If-Match header")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"If-Match": err,
+		})...)
+
+		return
+	}
+
+	res, err := s.domain.UpdateProfile(r.Context(), initiator.ID, profile.UpdateParams{
+		BirthDate: profile.SetField(birthDate),
+		Version:   version,
+	})
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to update birth date")
+		switch {
+		case errors.Is(err, errx.ErrorProfileNotFound):
+			ape.RenderErr(w, problems.Unauthorized("profile for user does not exist"))
+		case errors.Is(err, errx.ErrorProfileVersionConflict):
+			ape.RenderErr(w, preconditionFailed("profile was modified since it was last fetched"))
+		case errors.Is(err, errx.ErrorUserTooYoung):
+			ape.RenderErr(w, problems.Forbidden("birthday must be at least 12 years ago"))
+		case errors.Is(err, errx.ErrorBirthdateIsNotValid):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"birth_date": fmt.Errorf("birth date is invalid %s", err),
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	w.Header().Set("ETag", profileETag(res))
+	ape.Render(w, http.StatusOK, responses.Profile(res))
+}
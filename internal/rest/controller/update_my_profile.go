@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/umisto/ape"
@@ -14,6 +15,7 @@ import (
 	"github.com/umisto/profiles-svc/internal/rest/meta"
 	"github.com/umisto/profiles-svc/internal/rest/requests"
 	"github.com/umisto/profiles-svc/internal/rest/responses"
+	"github.com/umisto/profiles-svc/resources"
 )
 
 func (s Service) UpdateMyProfile(w http.ResponseWriter, r *http.Request) {
@@ -42,24 +44,73 @@ func (s Service) UpdateMyProfile(w http.ResponseWriter, r *http.Request) {
 				initiator.ID,
 			),
 		})...)
+
+		return
+	}
+
+	version, err := ifMatchVersion(r)
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid If-Match header")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"If-Match": err,
+		})...)
+
+		return
+	}
+
+	// absent attributes keep their value, null clears it
+	params := profile.UpdateParams{
+		Pseudonym:   stringField(req.Data.Attributes.Pseudonym),
+		Description: stringField(req.Data.Attributes.Description),
+		Avatar:      stringField(req.Data.Attributes.Avatar),
+		Banner:      stringField(req.Data.Attributes.Banner),
+		AccentColor: stringField(req.Data.Attributes.AccentColor),
+		Sex:         stringField(req.Data.Attributes.Sex),
+		Version:     version,
+	}
+
+	if req.Data.Attributes.BirthDate.IsSet() {
+		params.BirthDate = profile.ClearField[time.Time]()
 	}
+	if v := req.Data.Attributes.BirthDate.Get(); v != nil {
+		birthDate, err := time.Parse(time.DateOnly, *v)
+		if err != nil {
+			s.log.WithError(err).Errorf("invalid birth date in update profile request")
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/birth_date": fmt.Errorf("birth date format is invalid: %w", err),
+			})...)
 
-	res, err := s.domain.UpdateProfile(r.Context(), initiator.ID, profile.UpdateParams{
-		Pseudonym:   req.Data.Attributes.Pseudonym,
-		Description: req.Data.Attributes.Description,
-		Avatar:      req.Data.Attributes.Avatar,
-	})
+			return
+		}
+		params.BirthDate = profile.SetField(birthDate)
+	}
+
+	res, err := s.domain.UpdateProfile(r.Context(), initiator.ID, params)
 	if err != nil {
 		s.log.WithError(err).Errorf("failed to update profile")
 		switch {
 		case errors.Is(err, errx.ErrorProfileNotFound):
 			ape.RenderErr(w, problems.Unauthorized("profile for user does not exist"))
+		case errors.Is(err, errx.ErrorProfileVersionConflict):
+			ape.RenderErr(w, preconditionFailed("profile was modified since it was last fetched"))
 		case errors.Is(err, errx.ErrorUserTooYoung):
 			ape.RenderErr(w, problems.Forbidden("birthday must be at least 12 years ago"))
 		case errors.Is(err, errx.ErrorSexIsNotValid):
 			ape.RenderErr(w, problems.BadRequest(validation.Errors{
 				"sex": fmt.Errorf("sex value is not supported, %s", err),
 			})...)
+		case errors.Is(err, errx.ErrorAvatarURLNotAllowed):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"avatar": fmt.Errorf("avatar must be uploaded, external urls are not allowed"),
+			})...)
+		case errors.Is(err, errx.ErrorBannerURLIsNotValid):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"banner": fmt.Errorf("banner must be an absolute http or https url"),
+			})...)
+		case errors.Is(err, errx.ErrorAccentColorIsNotValid):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"accent_color": fmt.Errorf("accent color must be a #RRGGBB hex color, %s", err),
+			})...)
 		case errors.Is(err, errx.ErrorBirthdateIsNotValid):
 			ape.RenderErr(w, problems.BadRequest(validation.Errors{
 				"birth_date": fmt.Errorf("birth date format is invalid %s", err),
@@ -71,5 +122,10 @@ func (s Service) UpdateMyProfile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	w.Header().Set("ETag", profileETag(res))
 	ape.Render(w, http.StatusOK, responses.Profile(res))
 }
+
+func stringField(v resources.NullableString) profile.Field[string] {
+	return profile.Field[string]{Set: v.IsSet(), Value: v.Get()}
+}
@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/domain/modules/profile"
+	"github.com/umisto/profiles-svc/internal/rest/meta"
+	"github.com/umisto/profiles-svc/internal/rest/requests"
+	"github.com/umisto/profiles-svc/internal/rest/responses"
+)
+
+func (s Service) UpdateMySex(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	req, err := requests.UpdateSex(r)
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid update sex request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	if req.Data.Id != initiator.ID {
+		s.log.Errorf("id in body %s and initiator id %s mismatch for update sex request", req.Data.Id, initiator.ID)
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"data/id": fmt.Errorf("id in body: %s and initiator id: %s mismatch", req.Data.Id, initiator.ID),
+		})...)
+
+		return
+	}
+
+	version, err := ifMatchVersion(r)
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid If-Match header")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"If-Match": err,
+		})...)
+
+		return
+	}
+
+	res, err := s.domain.UpdateProfile(r.Context(), initiator.ID, profile.UpdateParams{
+		Sex:     profile.SetField(req.Data.Attributes.Sex),
+		Version: version,
+	})
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to update sex")
+		switch {
+		case errors.Is(err, errx.ErrorProfileNotFound):
+			ape.RenderErr(w, problems.Unauthorized("profile for user does not exist"))
+		case errors.Is(err, errx.ErrorProfileVersionConflict):
+			ape.RenderErr(w, preconditionFailed("profile was modified since it was last fetched"))
+		case errors.Is(err, errx.ErrorSexIsNotValid):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"sex": fmt.Errorf("sex value is not supported, %s", err),
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	w.Header().Set("ETag", profileETag(res))
+	ape.Render(w, http.StatusOK, responses.Profile(res))
+}
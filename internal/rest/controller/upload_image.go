@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/jsonapi"
+	"github.com/google/uuid"
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/domain/modules/profile"
+
+	"github.com/umisto/profiles-svc/internal/rest/meta"
+	"github.com/umisto/profiles-svc/internal/rest/requests"
+	"github.com/umisto/profiles-svc/internal/rest/responses"
+)
+
+func (s Service) UpdateMyAvatar(w http.ResponseWriter, r *http.Request) {
+	s.uploadMyImage(w, r, requests.AvatarFormField, requests.UpdateAvatar, s.domain.UpdateProfileAvatar)
+}
+
+// uploadMyImage handles an image upload of the initiator, field names the multipart form field.
+func (s Service) uploadMyImage(
+	w http.ResponseWriter,
+	r *http.Request,
+	field string,
+	read func(r *http.Request) ([]byte, error),
+	update func(ctx context.Context, accountID uuid.UUID, params profile.ImageParams) (entity.Profile, error),
+) {
+	initiator, err := meta.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	image, err := read(r)
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid upload %s request", field)
+
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			ape.RenderErr(w, payloadTooLarge(fmt.Sprintf("request body exceeds %d bytes", tooLarge.Limit)))
+		} else {
+			ape.RenderErr(w, problems.BadRequest(err)...)
+		}
+
+		return
+	}
+
+	version, err := ifMatchVersion(r)
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid If-Match header")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"If-Match": err,
+		})...)
+
+		return
+	}
+
+	res, err := update(r.Context(), initiator.ID, profile.ImageParams{
+		Image:   image,
+		Version: version,
+	})
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to update %s", field)
+		switch {
+		case errors.Is(err, errx.ErrorProfileNotFound):
+			ape.RenderErr(w, problems.Unauthorized("profile for user does not exist"))
+		case errors.Is(err, errx.ErrorProfileVersionConflict):
+			ape.RenderErr(w, preconditionFailed("profile was modified since it was last fetched"))
+		case errors.Is(err, errx.ErrorImageTooLarge):
+			ape.RenderErr(w, payloadTooLarge(fmt.Sprintf("%s file is too large", field)))
+		case errors.Is(err, errx.ErrorImageIsNotValid):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"body/" + field: fmt.Errorf("%s is not a supported image, %s", field, err),
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	w.Header().Set("ETag", profileETag(res))
+	ape.Render(w, http.StatusOK, responses.Profile(res))
+}
+
+func payloadTooLarge(detail string) *jsonapi.ErrorObject {
+	return &jsonapi.ErrorObject{
+		Title:  http.StatusText(http.StatusRequestEntityTooLarge),
+		Status: strconv.Itoa(http.StatusRequestEntityTooLarge),
+		Detail: detail,
+	}
+}
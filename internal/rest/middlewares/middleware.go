@@ -2,19 +2,38 @@ package middlewares
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/umisto/logium"
+	"github.com/umisto/profiles-svc/internal/metrics"
+	"github.com/umisto/profiles-svc/internal/tracing"
 	"github.com/umisto/restkit/mdlv"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Service struct {
-	log logium.Logger
+	log  logium.Logger
+	spec routers.Router
 }
 
-func New(log logium.Logger) Service {
-	return Service{
-		log: log,
+// New loads the OpenAPI spec requests are validated against, see ValidateRequest.
+func New(log logium.Logger, spec []byte) (Service, error) {
+	router, err := newSpecRouter(spec)
+	if err != nil {
+		return Service{}, err
 	}
+
+	return Service{
+		log:  log,
+		spec: router,
+	}, nil
 }
 
 func (s Service) Auth(userCtxKey interface{}, skUser string) func(http.Handler) http.Handler {
@@ -24,3 +43,60 @@ func (s Service) Auth(userCtxKey interface{}, skUser string) func(http.Handler)
 func (s Service) RoleGrant(userCtxKey interface{}, allowedRoles map[string]bool) func(http.Handler) http.Handler {
 	return mdlv.SystemRoleGrant(userCtxKey, allowedRoles)
 }
+
+// Metrics records request count and latency per chi route pattern, so path parameters
+// like user ids do not blow up label cardinality.
+func (s Service) Metrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		next.ServeHTTP(ww, r)
+
+		route := "unmatched"
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+			route = rctx.RoutePattern()
+		}
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+
+		metrics.HTTPRequests.WithLabelValues(r.Method, route, strconv.Itoa(status)).Inc()
+		metrics.HTTPRequestDuration.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+	})
+}
+
+// Tracing starts a server span for every request, continuing the W3C trace context of the caller.
+// The span is named after the chi route pattern once routing is done.
+func (s Service) Tracing(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := tracing.StartKind(
+			tracing.ExtractHTTP(r.Context(), propagation.HeaderCarrier(r.Header)),
+			r.Method,
+			trace.SpanKindServer,
+			attribute.String("http.request.method", r.Method),
+			attribute.String("url.path", r.URL.Path),
+		)
+		defer span.End()
+
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		r = r.WithContext(ctx)
+
+		next.ServeHTTP(ww, r)
+
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+			span.SetName(r.Method + " " + rctx.RoutePattern())
+			span.SetAttributes(attribute.String("http.route", rctx.RoutePattern()))
+		}
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	})
+}
@@ -0,0 +1,211 @@
+package middlewares
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/metrics"
+	"github.com/umisto/profiles-svc/internal/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func scrapeMetrics(t *testing.T) string {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	body, err := io.ReadAll(w.Body)
+	if err != nil {
+		t.Fatalf("reading metrics: %v", err)
+	}
+
+	return string(body)
+}
+
+func TestMetrics(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(Service{}.Metrics)
+	r.Route("/metrics-test/profiles", func(r chi.Router) {
+		r.Get("/{user_id}", func(w http.ResponseWriter, r *http.Request) {})
+		r.Put("/{user_id}/official", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		})
+	})
+
+	ids := []string{uuid.NewString(), uuid.NewString(), uuid.NewString()}
+	for _, id := range ids {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/metrics-test/profiles/"+id, nil))
+	}
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPut, "/metrics-test/profiles/"+ids[0]+"/official", nil))
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/metrics-test/unknown/"+ids[0], nil))
+
+	body := scrapeMetrics(t)
+
+	for _, want := range []string{
+		`profiles_svc_http_requests_total{method="GET",route="/metrics-test/profiles/{user_id}",status="200"} 3`,
+		`profiles_svc_http_requests_total{method="PUT",route="/metrics-test/profiles/{user_id}/official",status="403"} 1`,
+		`profiles_svc_http_requests_total{method="GET",route="unmatched",status="404"} 1`,
+		`profiles_svc_http_request_duration_seconds_count{method="GET",route="/metrics-test/profiles/{user_id}"} 3`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Fatalf("expected metrics to contain %s, got:\n%s", want, body)
+		}
+	}
+	for _, id := range ids {
+		if strings.Contains(body, id) {
+			t.Fatalf("expected no raw path in the labels, found %s", id)
+		}
+	}
+}
+
+// recordSpans installs a tracer provider that keeps finished spans in memory for the test.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+
+	return recorder
+}
+
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, attr := range span.Attributes() {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+
+	return attribute.Value{}
+}
+
+func TestTracing(t *testing.T) {
+	const (
+		traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+		spanID  = "00f067aa0ba902b7"
+	)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		parent     string
+		wantName   string
+		wantRoute  string
+		wantStatus int
+		wantError  bool
+	}{
+		{
+			name:       "named after the route pattern",
+			method:     http.MethodGet,
+			path:       "/profiles/" + uuid.NewString(),
+			wantName:   "GET /profiles/{user_id}",
+			wantRoute:  "/profiles/{user_id}",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "continues the trace of the caller",
+			method:     http.MethodGet,
+			path:       "/profiles/" + uuid.NewString(),
+			parent:     "00-" + traceID + "-" + spanID + "-01",
+			wantName:   "GET /profiles/{user_id}",
+			wantRoute:  "/profiles/{user_id}",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "server errors fail the span",
+			method:     http.MethodPost,
+			path:       "/profiles/" + uuid.NewString() + "/broken",
+			wantName:   "POST /profiles/{user_id}/broken",
+			wantRoute:  "/profiles/{user_id}/broken",
+			wantStatus: http.StatusInternalServerError,
+			wantError:  true,
+		},
+		{
+			name:       "unmatched request keeps the method as name",
+			method:     http.MethodGet,
+			path:       "/unknown",
+			wantName:   http.MethodGet,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := recordSpans(t)
+
+			var handlerSpan trace.SpanContext
+			r := chi.NewRouter()
+			r.Use(Service{}.Tracing)
+			r.Get("/profiles/{user_id}", func(w http.ResponseWriter, r *http.Request) {
+				_, span := tracing.Start(r.Context(), "profile.GetProfileByID")
+				handlerSpan = span.SpanContext()
+				span.End()
+			})
+			r.Post("/profiles/{user_id}/broken", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			})
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.parent != "" {
+				req.Header.Set("traceparent", tt.parent)
+			}
+			r.ServeHTTP(httptest.NewRecorder(), req)
+
+			var server sdktrace.ReadOnlySpan
+			for _, span := range recorder.Ended() {
+				if span.SpanKind() == trace.SpanKindServer {
+					server = span
+				}
+			}
+			if server == nil {
+				t.Fatalf("expected a server span, got %d spans", len(recorder.Ended()))
+			}
+
+			if server.Name() != tt.wantName {
+				t.Fatalf("expected span name %q, got %q", tt.wantName, server.Name())
+			}
+			if route := spanAttribute(server, "http.route").AsString(); route != tt.wantRoute {
+				t.Fatalf("expected route %q, got %q", tt.wantRoute, route)
+			}
+			if path := spanAttribute(server, "url.path").AsString(); path != tt.path {
+				t.Fatalf("expected path %q, got %q", tt.path, path)
+			}
+			if status := spanAttribute(server, "http.response.status_code").AsInt64(); status != int64(tt.wantStatus) {
+				t.Fatalf("expected status code %d, got %d", tt.wantStatus, status)
+			}
+			if failed := server.Status().Code == codes.Error; failed != tt.wantError {
+				t.Fatalf("expected the span to fail: %t, got %+v", tt.wantError, server.Status())
+			}
+
+			if tt.parent != "" {
+				if server.SpanContext().TraceID().String() != traceID || server.Parent().SpanID().String() != spanID {
+					t.Fatalf("expected the span to continue trace %s of span %s, got %s of %s",
+						traceID, spanID, server.SpanContext().TraceID(), server.Parent().SpanID())
+				}
+			}
+			if handlerSpan.IsValid() && handlerSpan.TraceID() != server.SpanContext().TraceID() {
+				t.Fatalf("expected spans of the handler to join the request trace")
+			}
+		})
+	}
+}
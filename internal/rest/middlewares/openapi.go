@@ -0,0 +1,144 @@
+package middlewares
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/ape"
+	"github.com/umisto/ape/problems"
+)
+
+const jsonAPIMediaType = "application/vnd.api+json"
+
+// uuidFormat accepts any UUID version, kin-openapi's predefined one is limited to v1-v5.
+const uuidFormat = `^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`
+
+func newSpecRouter(spec []byte) (routers.Router, error) {
+	// string formats are registered globally in kin-openapi
+	openapi3.DefineStringFormatValidator("uuid", openapi3.NewRegexpFormatValidator(uuidFormat))
+
+	loader := openapi3.NewLoader()
+
+	doc, err := loader.LoadFromData(spec)
+	if err != nil {
+		return nil, fmt.Errorf("loading openapi spec: %w", err)
+	}
+	if err = doc.Validate(loader.Context); err != nil {
+		return nil, fmt.Errorf("invalid openapi spec: %w", err)
+	}
+
+	// match requests whatever host the service is reached on
+	doc.Servers = nil
+
+	return gorillamux.NewRouter(doc)
+}
+
+// ValidateRequest checks path and query params, headers and bodies against the OpenAPI spec and
+// answers 400 with a JSON:API error per invalid field. Requests to paths missing from the spec,
+// e.g. /metrics, are passed through as is. Protected routes run it after the Auth middleware.
+func (s Service) ValidateRequest(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vr := r.Clone(r.Context())
+		if path := strings.TrimSuffix(vr.URL.Path, "/"); path != "" {
+			vr.URL.Path = path
+		}
+		// plain JSON is accepted for JSON:API bodies, as the handlers always did
+		mediaType, _, _ := mime.ParseMediaType(vr.Header.Get("Content-Type"))
+		if mediaType == "application/json" {
+			vr.Header.Set("Content-Type", jsonAPIMediaType)
+		}
+
+		route, pathParams, err := s.spec.FindRoute(vr)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		err = openapi3filter.ValidateRequest(r.Context(), &openapi3filter.RequestValidationInput{
+			Request:    vr,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				// uploads are streamed to the handler under its own size limit, not buffered here
+				ExcludeRequestBody:  mediaType == "multipart/form-data",
+				MultiError:          true,
+				SkipSettingDefaults: true,
+				AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+			},
+		})
+		if err != nil {
+			s.log.WithError(err).Warnf("request does not match openapi spec: %s %s", r.Method, r.URL.Path)
+			ape.RenderErr(w, problems.BadRequest(specErrors(err))...)
+
+			return
+		}
+
+		// the body has been read by the validator, hand its buffered copy to the handler
+		r.Body = vr.Body
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// specErrors converts validation errors to validation.Errors keyed like the hand written
+// request decoders: "query/<name>", "path/<name>", "header/<name>" or the body field path, e.g. "data/id".
+func specErrors(err error) validation.Errors {
+	errs := validation.Errors{}
+
+	var multi openapi3.MultiError
+	if !errors.As(err, &multi) {
+		multi = openapi3.MultiError{err}
+	}
+
+	for _, e := range multi {
+		var reqErr *openapi3filter.RequestError
+		if !errors.As(e, &reqErr) {
+			errs["request"] = e
+			continue
+		}
+
+		if reqErr.Parameter != nil {
+			errs[reqErr.Parameter.In+"/"+reqErr.Parameter.Name] = errors.New(requestErrorReason(reqErr))
+			continue
+		}
+
+		var schemaErrs openapi3.MultiError
+		if !errors.As(reqErr.Err, &schemaErrs) {
+			schemaErrs = openapi3.MultiError{reqErr.Err}
+		}
+		for _, se := range schemaErrs {
+			var schemaErr *openapi3.SchemaError
+			if se == nil || !errors.As(se, &schemaErr) {
+				errs["body"] = errors.New(requestErrorReason(reqErr))
+				continue
+			}
+
+			key := strings.Join(schemaErr.JSONPointer(), "/")
+			if key == "" {
+				key = "body"
+			}
+			errs[key] = errors.New(schemaErr.Reason)
+		}
+	}
+
+	return errs
+}
+
+func requestErrorReason(err *openapi3filter.RequestError) string {
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err.Err, &schemaErr) {
+		return schemaErr.Reason
+	}
+	if err.Err != nil {
+		return err.Err.Error()
+	}
+	return err.Reason
+}
@@ -0,0 +1,28 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/profiles-svc/resources"
+)
+
+func BatchProfiles(r *http.Request) (req resources.BatchProfiles, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In(resources.ProfilesBatchType)),
+
+		"data/attributes/usernames": validation.Validate(req.Data.Attributes.Usernames, validation.Each(validation.Required)),
+	}
+
+	if len(req.Data.Attributes.AccountIds) == 0 && len(req.Data.Attributes.Usernames) == 0 {
+		errs["data/attributes"] = validation.NewError("validation_required", "at least one account id or username is required")
+	}
+
+	return req, errs.Filter()
+}
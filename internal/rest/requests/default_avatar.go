@@ -0,0 +1,49 @@
+package requests
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/profiles-svc/internal/domain/modules/profile"
+)
+
+func DefaultAvatar(r *http.Request) (params profile.DefaultAvatarParams, err error) {
+	q := r.URL.Query()
+	errs := validation.Errors{}
+
+	params = profile.DefaultAvatarParams{
+		Style:  profile.DefaultAvatarStyleIdenticon,
+		Format: profile.DefaultAvatarFormatPNG,
+		Size:   profile.DefaultAvatarSize,
+	}
+
+	if style := strings.TrimSpace(q.Get("style")); style != "" {
+		errs["query/style"] = validation.Validate(style, validation.In(
+			profile.DefaultAvatarStyleIdenticon,
+			profile.DefaultAvatarStyleInitials,
+		))
+		params.Style = style
+	}
+
+	if format := strings.TrimSpace(q.Get("format")); format != "" {
+		errs["query/format"] = validation.Validate(format, validation.In(
+			profile.DefaultAvatarFormatPNG,
+			profile.DefaultAvatarFormatSVG,
+		))
+		params.Format = format
+	}
+
+	if raw := strings.TrimSpace(q.Get("size")); raw != "" {
+		size, convErr := strconv.Atoi(raw)
+		if convErr != nil {
+			errs["query/size"] = convErr
+		} else {
+			errs["query/size"] = validation.Validate(size, validation.Min(profile.MinDefaultAvatarSize), validation.Max(profile.MaxDefaultAvatarSize))
+			params.Size = size
+		}
+	}
+
+	return params, errs.Filter()
+}
@@ -0,0 +1,34 @@
+package requests
+
+import (
+	"net/http"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/kafkakit/box"
+	"github.com/umisto/profiles-svc/internal/domain/modules/inbox"
+)
+
+func FilterInboxEvents(r *http.Request) (params inbox.FilterParams, err error) {
+	q := r.URL.Query()
+	errs := validation.Errors{}
+
+	if status := strings.TrimSpace(q.Get("status")); status != "" {
+		errs["query/status"] = validation.Validate(status, validation.In(
+			box.InboxStatusPending,
+			box.InboxStatusProcessing,
+			box.InboxStatusProcessed,
+			box.InboxStatusFailed,
+		))
+		params.Status = &status
+	}
+
+	if eventType := strings.TrimSpace(q.Get("type")); eventType != "" {
+		params.Type = &eventType
+	}
+
+	params.CreatedAfter = queryTime(q.Get("created_after"), "query/created_after", errs)
+	params.CreatedBefore = queryTime(q.Get("created_before"), "query/created_before", errs)
+
+	return params, errs.Filter()
+}
@@ -0,0 +1,64 @@
+package requests
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/profiles-svc/internal/domain/modules/profile"
+)
+
+// FilterProfiles reads profile listing filters from the query string. Sort follows the
+// JSON:API convention, a leading '-' means descending order, e.g. sort=-updated_at.
+func FilterProfiles(r *http.Request) (params profile.FilterParams, err error) {
+	q := r.URL.Query()
+	errs := validation.Errors{}
+
+	if usernameLike := strings.TrimSpace(q.Get("username_like")); usernameLike != "" {
+		params.UsernamePrefix = &usernameLike
+	}
+
+	if pseudonym := strings.TrimSpace(q.Get("pseudonym")); pseudonym != "" {
+		params.PseudonymPrefix = &pseudonym
+	}
+
+	if raw := q.Get("official"); raw != "" {
+		official, err := strconv.ParseBool(raw)
+		if err != nil {
+			errs["query/official"] = fmt.Errorf("must be a boolean: %w", err)
+		} else {
+			params.Official = &official
+		}
+	}
+
+	params.CreatedAfter = queryTime(q.Get("created_after"), "query/created_after", errs)
+	params.CreatedBefore = queryTime(q.Get("created_before"), "query/created_before", errs)
+	params.UpdatedAfter = queryTime(q.Get("updated_after"), "query/updated_after", errs)
+
+	if raw := strings.TrimSpace(q.Get("sort")); raw != "" {
+		field, desc := strings.CutPrefix(raw, "-")
+		params.Sort = &profile.FilterSort{
+			Field:     field,
+			Ascending: !desc,
+		}
+	}
+
+	return params, errs.Filter()
+}
+
+func queryTime(raw, key string, errs validation.Errors) *time.Time {
+	if raw == "" {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		errs[key] = fmt.Errorf("must be an RFC 3339 timestamp: %w", err)
+		return nil
+	}
+
+	return &t
+}
@@ -0,0 +1,55 @@
+package requests
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+)
+
+const PageCursorQuery = "page[cursor]"
+
+// CursorMode reports whether the listing is requested in keyset mode, an empty
+// page[cursor] starts it from the first page.
+func CursorMode(r *http.Request) bool {
+	return r.URL.Query().Has(PageCursorQuery)
+}
+
+// ProfileCursor decodes the opaque page[cursor] query parameter, nil means the first page.
+func ProfileCursor(r *http.Request) (*entity.ProfileCursor, error) {
+	raw := strings.TrimSpace(r.URL.Query().Get(PageCursorQuery))
+	if raw == "" {
+		return nil, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, newDecodeError("query/page[cursor]", err)
+	}
+
+	parts := strings.Split(string(decoded), "|")
+	if len(parts) != 3 || (parts[0] != "f" && parts[0] != "b") {
+		return nil, newDecodeError("query/page[cursor]", fmt.Errorf("malformed cursor"))
+	}
+
+	nanos, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, newDecodeError("query/page[cursor]", err)
+	}
+
+	accountID, err := uuid.Parse(parts[2])
+	if err != nil {
+		return nil, newDecodeError("query/page[cursor]", err)
+	}
+
+	return &entity.ProfileCursor{
+		CreatedAt: time.Unix(0, nanos).UTC(),
+		AccountID: accountID,
+		Backward:  parts[0] == "b",
+	}, nil
+}
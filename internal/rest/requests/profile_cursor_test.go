@@ -0,0 +1,106 @@
+package requests
+
+import (
+	"encoding/base64"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+)
+
+func TestProfileCursor(t *testing.T) {
+	id := uuid.MustParse("0f8fad5b-d9cb-469f-a165-70867728950e")
+	createdAt := time.Date(2025, 3, 14, 15, 9, 26, 535897932, time.UTC)
+
+	encode := func(s string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(s))
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		want    *entity.ProfileCursor
+		wantErr bool
+	}{
+		{
+			name: "absent",
+		},
+		{
+			name:  "empty starts from the first page",
+			query: "page[cursor]=",
+		},
+		{
+			name:  "forward",
+			query: "page[cursor]=" + encode("f|1741964966535897932|"+id.String()),
+			want:  &entity.ProfileCursor{CreatedAt: createdAt, AccountID: id},
+		},
+		{
+			name:  "backward",
+			query: "page[cursor]=" + encode("b|1741964966535897932|"+id.String()),
+			want:  &entity.ProfileCursor{CreatedAt: createdAt, AccountID: id, Backward: true},
+		},
+		{
+			name:    "not base64",
+			query:   "page[cursor]=" + url.QueryEscape("!!!"),
+			wantErr: true,
+		},
+		{
+			name:    "padded base64",
+			query:   "page[cursor]=" + base64.URLEncoding.EncodeToString([]byte("f|1|"+id.String())),
+			wantErr: true,
+		},
+		{
+			name:    "unknown direction",
+			query:   "page[cursor]=" + encode("x|1|"+id.String()),
+			wantErr: true,
+		},
+		{
+			name:    "missing part",
+			query:   "page[cursor]=" + encode("f|"+id.String()),
+			wantErr: true,
+		},
+		{
+			name:    "extra part",
+			query:   "page[cursor]=" + encode("f|1|"+id.String()+"|1"),
+			wantErr: true,
+		},
+		{
+			name:    "bad time",
+			query:   "page[cursor]=" + encode("f|yesterday|"+id.String()),
+			wantErr: true,
+		},
+		{
+			name:    "bad account id",
+			query:   "page[cursor]=" + encode("f|1|not-a-uuid"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/profiles-svc/v1/profiles?"+tt.query, nil)
+
+			got, err := ProfileCursor(r)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got cursor %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			switch {
+			case tt.want == nil && got == nil:
+			case tt.want == nil || got == nil:
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			case !got.CreatedAt.Equal(tt.want.CreatedAt) || got.AccountID != tt.want.AccountID || got.Backward != tt.want.Backward:
+				t.Fatalf("expected %+v, got %+v", *tt.want, *got)
+			}
+		})
+	}
+}
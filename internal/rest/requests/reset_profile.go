@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/profiles-svc/resources"
+)
+
+func ResetProfile(r *http.Request) (req resources.ResetProfile, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/id":                validation.Validate(req.Data.Id, validation.Required),
+		"data/type":              validation.Validate(req.Data.Type, validation.Required, validation.In(resources.ProfileType)),
+		"data/attributes/reason": validation.Validate(req.Data.Attributes.Reason, validation.Required, validation.Length(1, 255)),
+	}
+
+	if chi.URLParam(r, "user_id") != req.Data.Id.String() {
+		errs["data/id"] = fmt.Errorf("query user_id and body data/id do not match")
+	}
+
+	return req, errs.Filter()
+}
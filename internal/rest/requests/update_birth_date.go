@@ -0,0 +1,29 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/profiles-svc/resources"
+)
+
+func UpdateBirthDate(r *http.Request) (req resources.UpdateBirthDate, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/id":   validation.Validate(req.Data.Id, validation.Required),
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In(resources.ProfileType)),
+
+		"data/attributes/birth_date": validation.Validate(
+			req.Data.Attributes.BirthDate,
+			validation.Required,
+			validation.Date(time.DateOnly),
+		),
+	}
+	return req, errs.Filter()
+}
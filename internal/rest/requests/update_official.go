@@ -28,7 +28,7 @@ func UpdateOfficial(r *http.Request) (req resources.UpdateOfficial, err error) {
 		"data/attributes": validation.Validate(req.Data.Attributes, validation.Required),
 	}
 
-	if chi.URLParam(r, "user_id") == req.Data.Id.String() {
+	if chi.URLParam(r, "user_id") != req.Data.Id.String() {
 		errs["data/id"] = fmt.Errorf("query user_id and body data/id do not match")
 	}
 
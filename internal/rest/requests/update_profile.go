@@ -3,6 +3,7 @@ package requests
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/umisto/profiles-svc/resources"
@@ -18,6 +19,8 @@ func UpdateProfile(r *http.Request) (req resources.UpdateProfile, err error) {
 		"data/id":         validation.Validate(req.Data.Id, validation.Required),
 		"data/type":       validation.Validate(req.Data.Type, validation.Required, validation.In(resources.ProfileType)),
 		"data/attributes": validation.Validate(req.Data.Attributes, validation.Required),
+
+		"data/attributes/birth_date": validation.Validate(req.Data.Attributes.BirthDate.Get(), validation.Date(time.DateOnly)),
 	}
 	return req, errs.Filter()
 }
@@ -0,0 +1,24 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/umisto/profiles-svc/resources"
+)
+
+func UpdateSex(r *http.Request) (req resources.UpdateSex, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/id":   validation.Validate(req.Data.Id, validation.Required),
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In(resources.ProfileType)),
+
+		"data/attributes/sex": validation.Validate(req.Data.Attributes.Sex, validation.Required),
+	}
+	return req, errs.Filter()
+}
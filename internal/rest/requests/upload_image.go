@@ -0,0 +1,62 @@
+package requests
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// AvatarFormField is the multipart form field holding an uploaded avatar.
+const AvatarFormField = "avatar"
+
+func UpdateAvatar(r *http.Request) ([]byte, error) {
+	return uploadedImage(r, AvatarFormField)
+}
+
+// uploadedImage reads the file of a form field from a multipart/form-data body. A body over the
+// limit set on the route fails with *http.MaxBytesError as is, so it can be told apart from a malformed one.
+func uploadedImage(r *http.Request, field string) ([]byte, error) {
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return nil, newDecodeError("body", err)
+	}
+
+	var image []byte
+
+	for {
+		part, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, uploadError(err)
+		}
+		if part.FormName() != field {
+			continue
+		}
+
+		image, err = io.ReadAll(part)
+		if err != nil {
+			return nil, uploadError(err)
+		}
+
+		break
+	}
+
+	errs := validation.Errors{
+		"body/" + field: validation.Validate(image, validation.Required),
+	}
+	return image, errs.Filter()
+}
+
+func uploadError(err error) error {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return err
+	}
+
+	return newDecodeError("body", fmt.Errorf("reading multipart form: %w", err))
+}
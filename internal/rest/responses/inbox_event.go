@@ -0,0 +1,61 @@
+package responses
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/umisto/kafkakit/box"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/resources"
+)
+
+func InboxEvent(m box.InboxEvent) resources.InboxEvent {
+	var payload interface{} = json.RawMessage(m.Payload)
+	if !json.Valid(m.Payload) {
+		payload = string(m.Payload)
+	}
+
+	return resources.InboxEvent{
+		Data: resources.InboxEventData{
+			Id:   m.ID,
+			Type: resources.InboxEventType,
+			Attributes: resources.InboxEventAttributes{
+				Topic:       m.Topic,
+				Key:         m.Key,
+				EventType:   m.Type,
+				Version:     m.Version,
+				Producer:    m.Producer,
+				Payload:     payload,
+				Status:      m.Status,
+				Attempts:    m.Attempts,
+				CreatedAt:   m.CreatedAt,
+				NextRetryAt: m.NextRetryAt,
+				ProcessedAt: m.ProcessedAt,
+			},
+		},
+	}
+}
+
+func InboxEventsCollection(r *http.Request, m entity.InboxEventCollection) resources.InboxEventsCollection {
+	links := paginationLinks(r, m.Offset, m.Size, m.Total)
+
+	resp := resources.InboxEventsCollection{
+		Data: make([]resources.InboxEventData, 0, len(m.Data)),
+		Links: resources.ProfilesCollectionLinks{
+			PageNumber: &links.Page,
+			PageSize:   int64(m.Size),
+			TotalItems: int64(m.Total),
+			Self:       links.Self,
+			First:      links.First,
+			Last:       links.Last,
+			Prev:       links.Prev,
+			Next:       links.Next,
+		},
+	}
+
+	for _, el := range m.Data {
+		resp.Data = append(resp.Data, InboxEvent(el).Data)
+	}
+
+	return resp
+}
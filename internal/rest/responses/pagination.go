@@ -0,0 +1,117 @@
+package responses
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+)
+
+const (
+	pageOffsetParam = "page[offset]"
+	pageLimitParam  = "page[limit]"
+	pageCursorParam = "page[cursor]"
+)
+
+type pageLinks struct {
+	// Page is the number of the page the first item falls on, absent in cursor mode.
+	Page  int64
+	Self  string
+	First string
+	Last  *string
+	Prev  *string
+	Next  *string
+}
+
+// paginationLinks builds self/first/last/prev/next links for the page starting at offset, keeping
+// the rest of the request query (filters, sorting) untouched. Links step from the offset itself,
+// so a page that does not start at a multiple of size neither skips nor repeats items.
+func paginationLinks(r *http.Request, offset, size, total uint) pageLinks {
+	if size == 0 {
+		size = 1
+	}
+
+	lastOffset := uint(0)
+	if total > 0 {
+		lastOffset = (total - 1) / size * size
+	}
+
+	link := func(o uint) string {
+		return pageLink(r, map[string]string{
+			pageOffsetParam: strconv.FormatUint(uint64(o), 10),
+			pageLimitParam:  strconv.FormatUint(uint64(size), 10),
+		})
+	}
+
+	last := link(lastOffset)
+	links := pageLinks{
+		Page:  int64(offset/size + 1),
+		Self:  link(offset),
+		First: link(0),
+		Last:  &last,
+	}
+	if offset > 0 {
+		// beyond the end the previous page is the last one, not an empty page before it
+		prev := link(min(offset-min(offset, size), lastOffset))
+		links.Prev = &prev
+	}
+	if offset+size < total {
+		next := link(offset + size)
+		links.Next = &next
+	}
+
+	return links
+}
+
+// cursorLinks builds links for a keyset page, there is no last page in this mode.
+func cursorLinks(r *http.Request, size uint, prev, next *string) pageLinks {
+	limit := strconv.FormatUint(uint64(size), 10)
+
+	links := pageLinks{
+		Self:  pageLink(r, map[string]string{pageCursorParam: r.URL.Query().Get(pageCursorParam), pageLimitParam: limit}),
+		First: pageLink(r, map[string]string{pageCursorParam: "", pageLimitParam: limit}),
+	}
+	if prev != nil {
+		link := pageLink(r, map[string]string{pageCursorParam: *prev, pageLimitParam: limit})
+		links.Prev = &link
+	}
+	if next != nil {
+		link := pageLink(r, map[string]string{pageCursorParam: *next, pageLimitParam: limit})
+		links.Next = &link
+	}
+
+	return links
+}
+
+func pageLink(r *http.Request, params map[string]string) string {
+	q := url.Values{}
+	for k, v := range r.URL.Query() {
+		q[k] = v
+	}
+	for k, v := range params {
+		q.Set(k, v)
+	}
+
+	u := url.URL{Path: r.URL.Path, RawQuery: q.Encode()}
+	return u.String()
+}
+
+// profileCursor encodes a cursor into the opaque page[cursor] value.
+func profileCursor(c *entity.ProfileCursor) *string {
+	if c == nil {
+		return nil
+	}
+
+	direction := "f"
+	if c.Backward {
+		direction = "b"
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(
+		fmt.Appendf(nil, "%s|%d|%s", direction, c.CreatedAt.UnixNano(), c.AccountID),
+	)
+	return &token
+}
@@ -0,0 +1,127 @@
+package responses
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/rest/requests"
+)
+
+func TestPaginationLinks(t *testing.T) {
+	link := func(offset string) *string {
+		l := "/profiles-svc/v1/profiles?filter%5Bofficial%5D=true&page%5Blimit%5D=10&page%5Boffset%5D=" + offset
+		return &l
+	}
+
+	tests := []struct {
+		name     string
+		offset   uint
+		total    uint
+		wantPage int64
+		wantSelf *string
+		wantLast *string
+		wantPrev *string
+		wantNext *string
+	}{
+		{name: "first page", offset: 0, total: 35, wantPage: 1, wantSelf: link("0"), wantLast: link("30"), wantNext: link("10")},
+		{name: "middle page", offset: 10, total: 35, wantPage: 2, wantSelf: link("10"), wantLast: link("30"), wantPrev: link("0"), wantNext: link("20")},
+		{name: "last page", offset: 30, total: 35, wantPage: 4, wantSelf: link("30"), wantLast: link("30"), wantPrev: link("20")},
+		{name: "unaligned offset", offset: 15, total: 35, wantPage: 2, wantSelf: link("15"), wantLast: link("30"), wantPrev: link("5"), wantNext: link("25")},
+		{name: "unaligned offset near the start", offset: 5, total: 35, wantPage: 1, wantSelf: link("5"), wantLast: link("30"), wantPrev: link("0"), wantNext: link("15")},
+		{name: "unaligned offset near the end", offset: 27, total: 35, wantPage: 3, wantSelf: link("27"), wantLast: link("30"), wantPrev: link("17")},
+		{name: "beyond the end", offset: 70, total: 35, wantPage: 8, wantSelf: link("70"), wantLast: link("30"), wantPrev: link("30")},
+		{name: "exactly full pages", offset: 20, total: 30, wantPage: 3, wantSelf: link("20"), wantLast: link("20"), wantPrev: link("10")},
+		{name: "empty", offset: 0, total: 0, wantPage: 1, wantSelf: link("0"), wantLast: link("0")},
+	}
+
+	eq := func(a, b *string) bool {
+		return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+	}
+	str := func(s *string) string {
+		if s == nil {
+			return "<nil>"
+		}
+		return *s
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/profiles-svc/v1/profiles?filter[official]=true&page[offset]=999", nil)
+
+			links := paginationLinks(r, tt.offset, 10, tt.total)
+
+			if links.Page != tt.wantPage {
+				t.Fatalf("expected page %d, got %d", tt.wantPage, links.Page)
+			}
+			if links.Self != *tt.wantSelf {
+				t.Fatalf("expected self %s, got %s", *tt.wantSelf, links.Self)
+			}
+			if links.First != *link("0") {
+				t.Fatalf("expected first %s, got %s", *link("0"), links.First)
+			}
+			if !eq(links.Last, tt.wantLast) {
+				t.Fatalf("expected last %s, got %s", str(tt.wantLast), str(links.Last))
+			}
+			if !eq(links.Prev, tt.wantPrev) {
+				t.Fatalf("expected prev %s, got %s", str(tt.wantPrev), str(links.Prev))
+			}
+			if !eq(links.Next, tt.wantNext) {
+				t.Fatalf("expected next %s, got %s", str(tt.wantNext), str(links.Next))
+			}
+		})
+	}
+}
+
+func TestProfileCursorRoundTrip(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		name   string
+		cursor entity.ProfileCursor
+	}{
+		{
+			name:   "forward",
+			cursor: entity.ProfileCursor{CreatedAt: time.Date(2025, 3, 14, 15, 9, 26, 535897932, time.UTC), AccountID: id},
+		},
+		{
+			name:   "backward",
+			cursor: entity.ProfileCursor{CreatedAt: time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC), AccountID: id, Backward: true},
+		},
+		{
+			name:   "before the epoch",
+			cursor: entity.ProfileCursor{CreatedAt: time.Date(1969, 7, 20, 20, 17, 0, 0, time.UTC), AccountID: id},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := profileCursor(&tt.cursor)
+			if token == nil {
+				t.Fatalf("expected a token")
+			}
+
+			r := httptest.NewRequest("GET", "/profiles-svc/v1/profiles?page[cursor]="+url.QueryEscape(*token), nil)
+
+			got, err := requests.ProfileCursor(r)
+			if err != nil {
+				t.Fatalf("decoding token %q: %v", *token, err)
+			}
+			if got == nil {
+				t.Fatalf("decoding token %q: expected a cursor", *token)
+			}
+			if !got.CreatedAt.Equal(tt.cursor.CreatedAt) || got.AccountID != tt.cursor.AccountID || got.Backward != tt.cursor.Backward {
+				t.Fatalf("expected %+v, got %+v", tt.cursor, *got)
+			}
+		})
+	}
+}
+
+func TestProfileCursorNil(t *testing.T) {
+	if token := profileCursor(nil); token != nil {
+		t.Fatalf("expected no token, got %q", *token)
+	}
+}
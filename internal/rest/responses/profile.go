@@ -1,6 +1,10 @@
 package responses
 
 import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/umisto/profiles-svc/internal/domain/entity"
 	"github.com/umisto/profiles-svc/resources"
 )
@@ -15,6 +19,9 @@ func Profile(m entity.Profile) resources.Profile {
 				Pseudonym:   m.Pseudonym,
 				Description: m.Description,
 				Avatar:      m.Avatar,
+				Banner:      m.Banner,
+				AccentColor: m.AccentColor,
+				Sex:         m.Sex,
 				Official:    m.Official,
 				UpdatedAt:   m.UpdatedAt,
 				CreatedAt:   m.CreatedAt,
@@ -22,12 +29,39 @@ func Profile(m entity.Profile) resources.Profile {
 		},
 	}
 
+	if m.Avatar == nil {
+		defaultAvatar := DefaultAvatarURL(m.AccountID)
+		resp.Data.Attributes.DefaultAvatar = &defaultAvatar
+	}
+
+	if m.BirthDate != nil {
+		birthDate := m.BirthDate.Format(time.DateOnly)
+		resp.Data.Attributes.BirthDate = &birthDate
+	}
+
 	return resp
 }
 
-func ProfileCollection(m entity.ProfileCollection) resources.ProfilesCollection {
+// DefaultAvatarURL is where the generated placeholder avatar of the account is served.
+func DefaultAvatarURL(accountID uuid.UUID) string {
+	return "/profiles-svc/v1/profiles/" + accountID.String() + "/avatar"
+}
+
+func ProfileCollection(r *http.Request, m entity.ProfileCollection) resources.ProfilesCollection {
+	links := paginationLinks(r, m.Offset, m.Size, m.Total)
+
 	resp := resources.ProfilesCollection{
 		Data: make([]resources.ProfileData, 0, len(m.Data)),
+		Links: resources.ProfilesCollectionLinks{
+			PageNumber: &links.Page,
+			PageSize:   int64(m.Size),
+			TotalItems: int64(m.Total),
+			Self:       links.Self,
+			First:      links.First,
+			Last:       links.Last,
+			Prev:       links.Prev,
+			Next:       links.Next,
+		},
 	}
 
 	for _, el := range m.Data {
@@ -38,3 +72,78 @@ func ProfileCollection(m entity.ProfileCollection) resources.ProfilesCollection
 
 	return resp
 }
+
+func ProfileCursorCollection(r *http.Request, m entity.ProfileCollection) resources.ProfilesCollection {
+	next := profileCursor(m.NextCursor)
+	prev := profileCursor(m.PrevCursor)
+	links := cursorLinks(r, m.Size, prev, next)
+
+	resp := resources.ProfilesCollection{
+		Data: make([]resources.ProfileData, 0, len(m.Data)),
+		Links: resources.ProfilesCollectionLinks{
+			PageSize:   int64(m.Size),
+			TotalItems: int64(m.Total),
+			Self:       links.Self,
+			First:      links.First,
+			Prev:       links.Prev,
+			Next:       links.Next,
+			NextCursor: next,
+			PrevCursor: prev,
+		},
+	}
+
+	for _, el := range m.Data {
+		resp.Data = append(resp.Data, Profile(el).Data)
+	}
+
+	return resp
+}
+
+func ProfileSearchCollection(r *http.Request, m entity.ProfileSearchCollection) resources.ProfilesSearchCollection {
+	links := paginationLinks(r, m.Offset, m.Size, m.Total)
+
+	resp := resources.ProfilesSearchCollection{
+		Data: make([]resources.ProfileSearchData, 0, len(m.Data)),
+		Links: resources.ProfilesCollectionLinks{
+			PageNumber: &links.Page,
+			PageSize:   int64(m.Size),
+			TotalItems: int64(m.Total),
+			Self:       links.Self,
+			First:      links.First,
+			Last:       links.Last,
+			Prev:       links.Prev,
+			Next:       links.Next,
+		},
+	}
+
+	for _, el := range m.Data {
+		p := Profile(el.Profile).Data
+
+		resp.Data = append(resp.Data, resources.ProfileSearchData{
+			Id:         p.Id,
+			Type:       p.Type,
+			Attributes: p.Attributes,
+			Meta: resources.ProfileSearchDataMeta{
+				Score: el.Score,
+			},
+		})
+	}
+
+	return resp
+}
+
+func ProfilesBatch(m entity.ProfileBatch) resources.ProfilesBatch {
+	resp := resources.ProfilesBatch{
+		Data: make([]resources.ProfileData, 0, len(m.Data)),
+		Meta: resources.ProfilesBatchMeta{
+			MissingAccountIds: m.MissingIDs,
+			MissingUsernames:  m.MissingUsernames,
+		},
+	}
+
+	for _, el := range m.Data {
+		resp.Data = append(resp.Data, Profile(el).Data)
+	}
+
+	return resp
+}
@@ -7,8 +7,11 @@ import (
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/umisto/logium"
 	"github.com/umisto/profiles-svc/internal"
+	"github.com/umisto/profiles-svc/internal/domain/modules/profile"
+	"github.com/umisto/profiles-svc/internal/metrics"
 	"github.com/umisto/profiles-svc/internal/rest/meta"
 	"github.com/umisto/restkit/roles"
 )
@@ -19,54 +22,122 @@ type Handlers interface {
 	//CreateMyProfile(w http.ResponseWriter, r *http.Request)
 	GetProfileByUsername(w http.ResponseWriter, r *http.Request)
 	GetProfileByID(w http.ResponseWriter, r *http.Request)
+	GetDefaultAvatar(w http.ResponseWriter, r *http.Request)
 
 	FilterProfiles(w http.ResponseWriter, r *http.Request)
+	SearchProfiles(w http.ResponseWriter, r *http.Request)
+	BatchProfiles(w http.ResponseWriter, r *http.Request)
 
 	UpdateMyProfile(w http.ResponseWriter, r *http.Request)
+	UpdateMyBirthDate(w http.ResponseWriter, r *http.Request)
+	UpdateMySex(w http.ResponseWriter, r *http.Request)
+	UpdateMyAvatar(w http.ResponseWriter, r *http.Request)
 	//UpdateMyUsername(w http.ResponseWriter, r *http.Request)
 	UpdateOfficial(w http.ResponseWriter, r *http.Request)
 
-	//ResetProfile(w http.ResponseWriter, r *http.Request)
+	ResetProfile(w http.ResponseWriter, r *http.Request)
+
+	FilterInboxEvents(w http.ResponseWriter, r *http.Request)
+	GetInboxEvent(w http.ResponseWriter, r *http.Request)
+	RequeueInboxEvent(w http.ResponseWriter, r *http.Request)
+	SkipInboxEvent(w http.ResponseWriter, r *http.Request)
+
+	Healthz(w http.ResponseWriter, r *http.Request)
+	Readyz(w http.ResponseWriter, r *http.Request)
 }
 
 type Middleware interface {
 	Auth(userCtxKey interface{}, skUser string) func(http.Handler) http.Handler
 	RoleGrant(userCtxKey interface{}, allowedRoles map[string]bool) func(http.Handler) http.Handler
+	Metrics(next http.Handler) http.Handler
+	Tracing(next http.Handler) http.Handler
+	ValidateRequest(next http.Handler) http.Handler
 }
 
-func Run(ctx context.Context, cfg internal.Config, log logium.Logger, m Middleware, h Handlers) {
+// multipartOverhead is what an image upload body may hold besides the file itself.
+const multipartOverhead = 64 << 10
+
+// newRouter wires handlers and middlewares to the API routes, files serves uploaded files below /profiles-svc/v1/files.
+func newRouter(cfg internal.Config, m Middleware, h Handlers, files http.Handler) http.Handler {
 	auth := m.Auth(meta.AccountDataCtxKey, cfg.JWT.User.AccessToken.SecretKey)
 	sysmoder := m.RoleGrant(meta.AccountDataCtxKey, map[string]bool{
 		roles.SystemModer: true,
 		roles.SystemAdmin: true,
 	})
+	sysadmin := m.RoleGrant(meta.AccountDataCtxKey, map[string]bool{
+		roles.SystemAdmin: true,
+	})
+
+	avatarMaxSize := cfg.Avatars.MaxSize
+	if avatarMaxSize <= 0 {
+		avatarMaxSize = profile.DefaultAvatarMaxSize
+	}
 
 	r := chi.NewRouter()
+	r.Use(m.Tracing, m.Metrics)
+
+	// requests are validated against the spec only once they are authorized,
+	// so a caller without access learns nothing about the expected input
+	validate := m.ValidateRequest
+
+	r.Get("/healthz", h.Healthz)
+	r.Get("/readyz", h.Readyz)
+	r.Handle("/metrics", metrics.Handler())
 
 	r.Route("/profiles-svc", func(r chi.Router) {
 		r.Route("/v1", func(r chi.Router) {
 			r.Route("/profiles", func(r chi.Router) {
-				r.Get("/", h.FilterProfiles)
-				r.Get("/u/{username}", h.GetProfileByUsername)
+				r.Group(func(r chi.Router) {
+					r.Use(validate)
 
-				r.With(auth).Route("/me", func(r chi.Router) {
+					r.Get("/", h.FilterProfiles)
+					r.Get("/search", h.SearchProfiles)
+					r.Post("/batch", h.BatchProfiles)
+					r.Get("/u/{username}", h.GetProfileByUsername)
+				})
+
+				r.With(auth, validate).Route("/me", func(r chi.Router) {
 					r.Get("/", h.GetMyProfile)
 					r.Put("/", h.UpdateMyProfile)
+					r.Patch("/", h.UpdateMyProfile)
+					r.Put("/birth_date", h.UpdateMyBirthDate)
+					r.Put("/sex", h.UpdateMySex)
+					r.With(middleware.RequestSize(avatarMaxSize+multipartOverhead)).Post("/avatar", h.UpdateMyAvatar)
 				})
 
 				r.Route("/{user_id}", func(r chi.Router) {
-					r.Get("/", h.GetProfileByID)
+					r.With(validate).Get("/", h.GetProfileByID)
+					r.With(validate).Get("/avatar", h.GetDefaultAvatar)
 
-					r.With(auth, sysmoder).Patch("/official", h.UpdateOfficial)
-					//r.With(auth, sysmoder).Put("/reset", h.ResetProfile)
+					r.With(auth, sysmoder, validate).Patch("/official", h.UpdateOfficial)
+					r.With(auth, sysmoder, validate).Put("/reset", h.ResetProfile)
+				})
+			})
+
+			r.Handle("/files/*", http.StripPrefix("/profiles-svc/v1/files", files))
+
+			r.With(auth, sysadmin, validate).Route("/admin", func(r chi.Router) {
+				r.Route("/inbox", func(r chi.Router) {
+					r.Get("/", h.FilterInboxEvents)
+
+					r.Route("/{event_id}", func(r chi.Router) {
+						r.Get("/", h.GetInboxEvent)
+						r.Post("/requeue", h.RequeueInboxEvent)
+						r.Post("/skip", h.SkipInboxEvent)
+					})
 				})
 			})
 		})
 	})
 
+	return r
+}
+
+// Run serves the API, files serves uploaded files below /profiles-svc/v1/files.
+func Run(ctx context.Context, cfg internal.Config, log logium.Logger, m Middleware, h Handlers, files http.Handler) {
 	srv := &http.Server{
 		Addr:              cfg.Rest.Port,
-		Handler:           r,
+		Handler:           newRouter(cfg, m, h, files),
 		ReadTimeout:       cfg.Rest.Timeouts.Read,
 		ReadHeaderTimeout: cfg.Rest.Timeouts.ReadHeader,
 		WriteTimeout:      cfg.Rest.Timeouts.Write,
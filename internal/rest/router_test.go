@@ -0,0 +1,165 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/umisto/logium"
+	"github.com/umisto/profiles-svc/docs"
+	"github.com/umisto/profiles-svc/internal"
+	"github.com/umisto/profiles-svc/internal/rest/middlewares"
+)
+
+// testMiddleware validates and instruments requests as in production, auth only checks that a token is present.
+type testMiddleware struct {
+	middlewares.Service
+}
+
+func (testMiddleware) Auth(_ interface{}, _ string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") == "" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (testMiddleware) RoleGrant(_ interface{}, _ map[string]bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler { return next }
+}
+
+// testHandlers answers 200 from every handler and records which one was reached.
+type testHandlers struct {
+	Handlers
+	reached *string
+}
+
+func (h testHandlers) FilterProfiles(_ http.ResponseWriter, _ *http.Request) {
+	*h.reached = "FilterProfiles"
+}
+
+func (h testHandlers) UpdateMySex(_ http.ResponseWriter, _ *http.Request) {
+	*h.reached = "UpdateMySex"
+}
+
+func newTestRouter(t *testing.T) (http.Handler, *string) {
+	t.Helper()
+
+	svc, err := middlewares.New(logium.NewLogger("debug", "text"), docs.Spec)
+	if err != nil {
+		t.Fatalf("loading spec: %v", err)
+	}
+
+	reached := new(string)
+
+	return newRouter(internal.Config{}, testMiddleware{svc}, testHandlers{reached: reached}, http.NotFoundHandler()), reached
+}
+
+func TestRouterPageCursor(t *testing.T) {
+	tests := []struct {
+		name        string
+		target      string
+		wantStatus  int
+		wantReached string
+	}{
+		{
+			name:        "empty cursor is the first page",
+			target:      "/profiles-svc/v1/profiles?page[cursor]=&page[limit]=10",
+			wantStatus:  http.StatusOK,
+			wantReached: "FilterProfiles",
+		},
+		{
+			name:        "cursor from links",
+			target:      "/profiles-svc/v1/profiles?page[cursor]=opaque&page[limit]=10",
+			wantStatus:  http.StatusOK,
+			wantReached: "FilterProfiles",
+		},
+		{
+			name:       "invalid limit",
+			target:     "/profiles-svc/v1/profiles?page[cursor]=&page[limit]=abc",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, reached := newTestRouter(t)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if *reached != tt.wantReached {
+				t.Fatalf("expected handler %q to be reached, got %q", tt.wantReached, *reached)
+			}
+		})
+	}
+}
+
+func TestRouterAuthBeforeValidation(t *testing.T) {
+	const (
+		validBody   = `{"data":{"id":"7b1a2d4e-3f5c-4a6b-8c9d-0e1f2a3b4c5d","type":"profile","attributes":{"sex":"female"}}}`
+		invalidBody = `{"data":{"type":"profile"}}`
+	)
+
+	tests := []struct {
+		name        string
+		token       string
+		body        string
+		wantStatus  int
+		wantReached string
+	}{
+		{
+			name:       "invalid body without a token",
+			body:       invalidBody,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "valid body without a token",
+			body:       validBody,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid body with a token",
+			token:      "Bearer token",
+			body:       invalidBody,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "valid body with a token",
+			token:       "Bearer token",
+			body:        validBody,
+			wantStatus:  http.StatusOK,
+			wantReached: "UpdateMySex",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, reached := newTestRouter(t)
+
+			r := httptest.NewRequest(http.MethodPut, "/profiles-svc/v1/profiles/me/sex", strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", "application/vnd.api+json")
+			if tt.token != "" {
+				r.Header.Set("Authorization", tt.token)
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if *reached != tt.wantReached {
+				t.Fatalf("expected handler %q to be reached, got %q", tt.wantReached, *reached)
+			}
+		})
+	}
+}
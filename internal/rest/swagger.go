@@ -0,0 +1,88 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	swaggerFiles "github.com/swaggo/files/v2"
+	"github.com/umisto/logium"
+	"github.com/umisto/profiles-svc/docs"
+	"github.com/umisto/profiles-svc/internal"
+)
+
+// swaggerInitializer replaces the one shipped with Swagger UI, which points to the petstore example.
+const swaggerInitializer = `window.onload = function() {
+  window.ui = SwaggerUIBundle({
+    url: %q,
+    dom_id: '#swagger-ui',
+    deepLinking: true,
+    presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+    plugins: [SwaggerUIBundle.plugins.DownloadUrl],
+    layout: "StandaloneLayout"
+  });
+};
+`
+
+// RunSwagger serves the bundled OpenAPI spec at <url>/api.yaml and Swagger UI at <url>/ on its own port.
+func RunSwagger(ctx context.Context, cfg internal.SwaggerConfig, log logium.Logger) {
+	base := "/" + strings.Trim(cfg.URL, "/")
+	if base == "/" {
+		base = "/swagger"
+	}
+	specURL := base + "/api.yaml"
+
+	r := chi.NewRouter()
+	r.Get(base, http.RedirectHandler(base+"/", http.StatusMovedPermanently).ServeHTTP)
+	r.Route(base, func(r chi.Router) {
+		r.Get("/api.yaml", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/yaml")
+			_, _ = w.Write(docs.Spec)
+		})
+		r.Get("/swagger-initializer.js", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/javascript")
+			_, _ = fmt.Fprintf(w, swaggerInitializer, specURL)
+		})
+		r.Handle("/*", http.StripPrefix(base, http.FileServerFS(swaggerFiles.FS)))
+	})
+
+	addr := cfg.Port
+	if !strings.Contains(addr, ":") {
+		addr = ":" + addr
+	}
+
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           r,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	log.Infof("serving swagger UI on %s%s/", addr, base)
+
+	errCh := make(chan error, 1)
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		} else {
+			errCh <- nil
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+	case err := <-errCh:
+		if err != nil {
+			log.Errorf("swagger server error: %v", err)
+		}
+	}
+
+	shCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shCtx); err != nil {
+		log.Errorf("swagger shutdown error: %v", err)
+	}
+}
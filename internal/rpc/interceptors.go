@@ -0,0 +1,113 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/umisto/logium"
+	"github.com/umisto/profiles-svc/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type callerCtxKey struct{}
+
+// Caller returns the name of the service that made the call, taken from its token subject.
+func Caller(ctx context.Context) string {
+	caller, _ := ctx.Value(callerCtxKey{}).(string)
+	return caller
+}
+
+// serviceAuth accepts HS256 service tokens issued for this service, the token subject names
+// the calling service. An empty allow list lets in any service holding a valid token.
+type serviceAuth struct {
+	secret   []byte
+	audience string
+	allowed  []string
+}
+
+func (a serviceAuth) Unary(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	var raw string
+	if values := md.Get("authorization"); len(values) > 0 {
+		raw, _ = strings.CutPrefix(values[0], "Bearer ")
+	}
+	if raw == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing service token")
+	}
+
+	claims := jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(raw, &claims, func(*jwt.Token) (any, error) {
+		return a.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithAudience(a.audience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid service token")
+	}
+
+	if claims.Subject == "" {
+		return nil, status.Error(codes.Unauthenticated, "service token has no subject")
+	}
+	if len(a.allowed) > 0 && !slices.Contains(a.allowed, claims.Subject) {
+		return nil, status.Errorf(codes.PermissionDenied, "service %s is not allowed", claims.Subject)
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("rpc.caller", claims.Subject))
+
+	return handler(context.WithValue(ctx, callerCtxKey{}, claims.Subject), req)
+}
+
+// traceUnary starts a server span continuing the W3C trace context from call metadata.
+func traceUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	carrier := make(map[string]string, len(md))
+	for key, values := range md {
+		if len(values) > 0 {
+			carrier[key] = values[0]
+		}
+	}
+
+	ctx, span := tracing.StartKind(
+		tracing.Extract(ctx, carrier),
+		strings.TrimPrefix(info.FullMethod, "/"),
+		trace.SpanKindServer,
+		attribute.String("rpc.system", "grpc"),
+	)
+	defer span.End()
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		span.SetAttributes(attribute.String("rpc.grpc.status_code", status.Code(err).String()))
+		if status.Code(err) == codes.Internal || status.Code(err) == codes.Unknown {
+			tracing.RecordError(span, err)
+		}
+	}
+
+	return resp, err
+}
+
+// recoverUnary turns a panic in a handler into an Internal error instead of crashing the service.
+func recoverUnary(log logium.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.WithError(fmt.Errorf("%v", r)).Errorf("panic in gRPC handler %s: %s", info.FullMethod, debug.Stack())
+				err = status.Error(codes.Internal, "internal error")
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,153 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/umisto/logium"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testSecret = "service-secret"
+
+func serviceToken(t *testing.T, method jwt.SigningMethod, secret string, claims jwt.RegisteredClaims) string {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(method, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("signing token: %v", err)
+	}
+
+	return token
+}
+
+func TestServiceAuth(t *testing.T) {
+	auth := serviceAuth{
+		secret:   []byte(testSecret),
+		audience: "profiles-svc",
+		allowed:  []string{"chat-svc"},
+	}
+
+	valid := func(subject string) jwt.RegisteredClaims {
+		return jwt.RegisteredClaims{
+			Subject:   subject,
+			Audience:  jwt.ClaimStrings{"profiles-svc"},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		}
+	}
+	expired := valid("chat-svc")
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	noExpiry := valid("chat-svc")
+	noExpiry.ExpiresAt = nil
+	otherAudience := valid("chat-svc")
+	otherAudience.Audience = jwt.ClaimStrings{"accounts-svc"}
+
+	tests := []struct {
+		name       string
+		header     string
+		wantCode   codes.Code
+		wantCaller string
+	}{
+		{
+			name:     "missing token",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "malformed token",
+			header:   "Bearer not.a.token",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "wrong secret",
+			header:   "Bearer " + serviceToken(t, jwt.SigningMethodHS256, "other-secret", valid("chat-svc")),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "other signing method",
+			header:   "Bearer " + serviceToken(t, jwt.SigningMethodHS512, testSecret, valid("chat-svc")),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "expired token",
+			header:   "Bearer " + serviceToken(t, jwt.SigningMethodHS256, testSecret, expired),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "token without expiry",
+			header:   "Bearer " + serviceToken(t, jwt.SigningMethodHS256, testSecret, noExpiry),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "token for another service",
+			header:   "Bearer " + serviceToken(t, jwt.SigningMethodHS256, testSecret, otherAudience),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "token without subject",
+			header:   "Bearer " + serviceToken(t, jwt.SigningMethodHS256, testSecret, valid("")),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "caller not allowed",
+			header:   "Bearer " + serviceToken(t, jwt.SigningMethodHS256, testSecret, valid("billing-svc")),
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:       "allowed caller",
+			header:     "Bearer " + serviceToken(t, jwt.SigningMethodHS256, testSecret, valid("chat-svc")),
+			wantCode:   codes.OK,
+			wantCaller: "chat-svc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.header != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.header))
+			}
+
+			var caller string
+			called := false
+			_, err := auth.Unary(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+				called = true
+				caller = Caller(ctx)
+				return nil, nil
+			})
+
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("expected code %s, got %s: %v", tt.wantCode, code, err)
+			}
+			if called != (tt.wantCode == codes.OK) {
+				t.Fatalf("expected the handler to be called: %t, got %t", tt.wantCode == codes.OK, called)
+			}
+			if caller != tt.wantCaller {
+				t.Fatalf("expected caller %q, got %q", tt.wantCaller, caller)
+			}
+		})
+	}
+}
+
+func TestRecoverUnary(t *testing.T) {
+	interceptor := recoverUnary(logium.NewLogger("debug", "text"))
+	info := &grpc.UnaryServerInfo{FullMethod: "/profiles.v1.ProfilesService/GetProfile"}
+
+	_, err := interceptor(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		panic("nil map write")
+	})
+	if code := status.Code(err); code != codes.Internal {
+		t.Fatalf("expected code %s after a panic, got %s: %v", codes.Internal, code, err)
+	}
+
+	resp, err := interceptor(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		return "ok", nil
+	})
+	if err != nil || resp != "ok" {
+		t.Fatalf("expected the handler result to pass through, got %v, %v", resp, err)
+	}
+}
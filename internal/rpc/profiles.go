@@ -0,0 +1,167 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	profilesv1 "github.com/umisto/profiles-svc/api/profiles/v1"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/domain/modules/profile"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s Server) GetProfile(ctx context.Context, req *profilesv1.GetProfileRequest) (*profilesv1.GetProfileResponse, error) {
+	accountID, err := uuid.Parse(req.GetAccountId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account id: %s", req.GetAccountId())
+	}
+
+	res, err := s.domain.GetProfileByID(ctx, accountID)
+	if err != nil {
+		return nil, s.domainErr(ctx, "failed to get profile by id", err)
+	}
+	if res.Hidden {
+		return nil, status.Error(codes.NotFound, "profile does not exist")
+	}
+
+	return &profilesv1.GetProfileResponse{Profile: profileProto(res)}, nil
+}
+
+func (s Server) GetProfileByUsername(
+	ctx context.Context,
+	req *profilesv1.GetProfileByUsernameRequest,
+) (*profilesv1.GetProfileByUsernameResponse, error) {
+	res, err := s.domain.GetProfileByUsername(ctx, req.GetUsername())
+	if err != nil {
+		return nil, s.domainErr(ctx, "failed to get profile by username", err)
+	}
+	if res.Hidden {
+		return nil, status.Error(codes.NotFound, "profile does not exist")
+	}
+
+	resp := &profilesv1.GetProfileByUsernameResponse{Profile: profileProto(res)}
+	if res.Username != req.GetUsername() {
+		resp.RedirectedFrom = &req.Username
+	}
+
+	return resp, nil
+}
+
+func (s Server) BatchGetProfiles(
+	ctx context.Context,
+	req *profilesv1.BatchGetProfilesRequest,
+) (*profilesv1.BatchGetProfilesResponse, error) {
+	ids := make([]uuid.UUID, 0, len(req.GetAccountIds()))
+	for _, raw := range req.GetAccountIds() {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid account id: %s", raw)
+		}
+		ids = append(ids, id)
+	}
+
+	res, err := s.domain.GetProfilesBatch(ctx, ids, req.GetUsernames())
+	if err != nil {
+		return nil, s.domainErr(ctx, "failed to get profiles batch", err)
+	}
+
+	resp := &profilesv1.BatchGetProfilesResponse{
+		Profiles:          make([]*profilesv1.Profile, 0, len(res.Data)),
+		MissingAccountIds: make([]string, 0, len(res.MissingIDs)),
+		MissingUsernames:  res.MissingUsernames,
+	}
+	for _, p := range res.Data {
+		resp.Profiles = append(resp.Profiles, profileProto(p))
+	}
+	for _, id := range res.MissingIDs {
+		resp.MissingAccountIds = append(resp.MissingAccountIds, id.String())
+	}
+
+	return resp, nil
+}
+
+func (s Server) FilterProfiles(
+	ctx context.Context,
+	req *profilesv1.FilterProfilesRequest,
+) (*profilesv1.FilterProfilesResponse, error) {
+	params := profile.FilterParams{
+		UsernamePrefix:  req.UsernamePrefix,
+		PseudonymPrefix: req.PseudonymPrefix,
+		Official:        req.Official,
+		CreatedAfter:    timePtr(req.GetCreatedAfter()),
+		CreatedBefore:   timePtr(req.GetCreatedBefore()),
+		UpdatedAfter:    timePtr(req.GetUpdatedAfter()),
+	}
+	if raw := strings.TrimSpace(req.GetSort()); raw != "" {
+		field, desc := strings.CutPrefix(raw, "-")
+		params.Sort = &profile.FilterSort{
+			Field:     field,
+			Ascending: !desc,
+		}
+	}
+
+	res, err := s.domain.FilterProfile(ctx, params, req.GetOffset(), req.GetLimit())
+	if err != nil {
+		return nil, s.domainErr(ctx, "failed to filter profiles", err)
+	}
+
+	resp := &profilesv1.FilterProfilesResponse{
+		Profiles: make([]*profilesv1.Profile, 0, len(res.Data)),
+		Total:    uint64(res.Total),
+	}
+	for _, p := range res.Data {
+		resp.Profiles = append(resp.Profiles, profileProto(p))
+	}
+
+	return resp, nil
+}
+
+// domainErr logs the error and maps it to a gRPC status, hiding internal details from the caller.
+func (s Server) domainErr(ctx context.Context, msg string, err error) error {
+	s.log.WithError(err).Errorf("%s, caller: %s", msg, Caller(ctx))
+
+	switch {
+	case errors.Is(err, errx.ErrorProfileNotFound):
+		return status.Error(codes.NotFound, "profile does not exist")
+	case errors.Is(err, errx.ErrorSortIsNotValid):
+		return status.Errorf(codes.InvalidArgument, "invalid sort, allowed fields: %s", strings.Join(profile.SortFields, ", "))
+	case errors.Is(err, errx.ErrorBatchTooLarge):
+		return status.Error(codes.InvalidArgument, "too many identifiers in batch")
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func profileProto(p entity.Profile) *profilesv1.Profile {
+	out := &profilesv1.Profile{
+		AccountId:   p.AccountID.String(),
+		Username:    p.Username,
+		Official:    p.Official,
+		Pseudonym:   p.Pseudonym,
+		Description: p.Description,
+		Avatar:      p.Avatar,
+		Sex:         p.Sex,
+		Version:     p.Version,
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+	}
+	if p.BirthDate != nil {
+		out.BirthDate = timestamppb.New(*p.BirthDate)
+	}
+
+	return out
+}
+
+func timePtr(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
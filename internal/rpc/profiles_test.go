@@ -0,0 +1,102 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/umisto/logium"
+	profilesv1 "github.com/umisto/profiles-svc/api/profiles/v1"
+	"github.com/umisto/profiles-svc/internal/domain/entity"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
+	"github.com/umisto/profiles-svc/internal/rest/controller"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDomain serves the profiles it holds, keyed by account id.
+type fakeDomain struct {
+	controller.Domain
+	profiles map[uuid.UUID]entity.Profile
+	err      error
+}
+
+func (d fakeDomain) GetProfileByID(_ context.Context, id uuid.UUID) (entity.Profile, error) {
+	if d.err != nil {
+		return entity.Profile{}, d.err
+	}
+
+	p, ok := d.profiles[id]
+	if !ok {
+		return entity.Profile{}, errx.ErrorProfileNotFound.Raise(fmt.Errorf("profile for user '%s' does not exist", id))
+	}
+
+	return p, nil
+}
+
+func TestGetProfile(t *testing.T) {
+	visible := entity.Profile{AccountID: uuid.New(), Username: "visible"}
+	hidden := entity.Profile{AccountID: uuid.New(), Username: "hidden", Hidden: true}
+
+	tests := []struct {
+		name         string
+		accountID    string
+		err          error
+		wantCode     codes.Code
+		wantUsername string
+	}{
+		{
+			name:         "found",
+			accountID:    visible.AccountID.String(),
+			wantCode:     codes.OK,
+			wantUsername: "visible",
+		},
+		{
+			name:      "invalid id",
+			accountID: "42",
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "not found",
+			accountID: uuid.NewString(),
+			wantCode:  codes.NotFound,
+		},
+		{
+			name:      "hidden is not found",
+			accountID: hidden.AccountID.String(),
+			wantCode:  codes.NotFound,
+		},
+		{
+			name:      "internal error is not leaked",
+			accountID: visible.AccountID.String(),
+			err:       errx.ErrorInternal.Raise(fmt.Errorf("connection refused to 10.0.0.5")),
+			wantCode:  codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := Server{
+				log: logium.NewLogger("debug", "text"),
+				domain: fakeDomain{
+					profiles: map[uuid.UUID]entity.Profile{visible.AccountID: visible, hidden.AccountID: hidden},
+					err:      tt.err,
+				},
+			}
+
+			resp, err := srv.GetProfile(context.Background(), &profilesv1.GetProfileRequest{AccountId: tt.accountID})
+
+			st := status.Convert(err)
+			if st.Code() != tt.wantCode {
+				t.Fatalf("expected code %s, got %s: %v", tt.wantCode, st.Code(), err)
+			}
+			if tt.wantCode == codes.Internal && st.Message() != "internal error" {
+				t.Fatalf("expected internal details to be hidden, got %q", st.Message())
+			}
+			if tt.wantCode == codes.OK && resp.GetProfile().GetUsername() != tt.wantUsername {
+				t.Fatalf("expected username %q, got %q", tt.wantUsername, resp.GetProfile().GetUsername())
+			}
+		})
+	}
+}
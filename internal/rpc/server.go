@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/umisto/logium"
+	profilesv1 "github.com/umisto/profiles-svc/api/profiles/v1"
+	"github.com/umisto/profiles-svc/internal"
+	"github.com/umisto/profiles-svc/internal/rest/controller"
+	"google.golang.org/grpc"
+)
+
+// Server exposes profile reads to other services over gRPC, using the same domain as the REST API.
+type Server struct {
+	profilesv1.UnimplementedProfilesServiceServer
+
+	log    logium.Logger
+	domain controller.Domain
+}
+
+func Run(ctx context.Context, cfg internal.Config, log logium.Logger, domain controller.Domain) {
+	auth := serviceAuth{
+		secret:   []byte(cfg.JWT.Service.SecretKey),
+		audience: cfg.Service.Name,
+		allowed:  cfg.GRPC.AllowedServices,
+	}
+
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(traceUnary, recoverUnary(log), auth.Unary),
+	)
+	profilesv1.RegisterProfilesServiceServer(srv, Server{
+		log:    log,
+		domain: domain,
+	})
+
+	lis, err := net.Listen("tcp", cfg.GRPC.Port)
+	if err != nil {
+		log.Errorf("gRPC listen error: %v", err)
+		return
+	}
+
+	log.Infof("starting gRPC service on %s", cfg.GRPC.Port)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(lis)
+	}()
+
+	select {
+	case <-ctx.Done():
+		log.Info("shutting down gRPC service...")
+	case err = <-errCh:
+		if err != nil {
+			log.Errorf("gRPC server error: %v", err)
+		}
+		return
+	}
+
+	// let in-flight calls finish, but do not wait for them forever
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		log.Info("gRPC server stopped")
+	case <-time.After(5 * time.Second):
+		srv.Stop()
+		log.Errorf("gRPC shutdown timed out, pending calls were cancelled")
+	}
+}
@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FS keeps objects as files under a local directory, Handler serves them over HTTP.
+type FS struct {
+	dir     string
+	baseURL string
+}
+
+// NewFS creates the directory if needed, baseURL is where Handler is mounted.
+func NewFS(dir, baseURL string) (FS, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return FS{}, fmt.Errorf("creating storage directory '%s': %w", dir, err)
+	}
+
+	return FS{
+		dir:     dir,
+		baseURL: baseURL,
+	}, nil
+}
+
+func (s FS) Put(_ context.Context, key string, data []byte, _ string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return fmt.Errorf("creating directory for '%s': %w", key, err)
+	}
+
+	// write aside and rename, so a file is never served half written
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("creating temp file for '%s': %w", key, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing '%s': %w", key, err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("writing '%s': %w", key, err)
+	}
+	if err = os.Chmod(tmp.Name(), 0o644); err != nil {
+		return fmt.Errorf("writing '%s': %w", key, err)
+	}
+
+	if err = os.Rename(tmp.Name(), name); err != nil {
+		return fmt.Errorf("writing '%s': %w", key, err)
+	}
+
+	return nil
+}
+
+func (s FS) DeleteAll(_ context.Context, prefix string) error {
+	name, err := s.path(prefix)
+	if err != nil {
+		return err
+	}
+
+	if err = os.RemoveAll(name); err != nil {
+		return fmt.Errorf("deleting '%s': %w", prefix, err)
+	}
+
+	return nil
+}
+
+func (s FS) URL(key string) string {
+	return joinURL(s.baseURL, key)
+}
+
+// Handler serves stored files relative to the request path, it must be mounted with the URL prefix
+// stripped. Directory listings are not served.
+func (s FS) Handler() http.Handler {
+	files := http.FileServer(http.Dir(s.dir))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name, err := s.path(strings.TrimPrefix(r.URL.Path, "/"))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		if info, err := os.Stat(name); err != nil || info.IsDir() {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		files.ServeHTTP(w, r)
+	})
+}
+
+func (s FS) path(key string) (string, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCleanKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		want    string
+		wantErr bool
+	}{
+		{name: "plain", key: "avatars/1/2/512.jpg", want: "avatars/1/2/512.jpg"},
+		{name: "dot segments inside", key: "avatars/./1/../1/512.jpg", want: "avatars/1/512.jpg"},
+		{name: "trailing slash", key: "avatars/1/", want: "avatars/1"},
+		{name: "empty", key: "", wantErr: true},
+		{name: "dot", key: ".", wantErr: true},
+		{name: "absolute", key: "/etc/passwd", wantErr: true},
+		{name: "parent", key: "..", wantErr: true},
+		{name: "escapes the root", key: "../secret", wantErr: true},
+		{name: "escapes through a directory", key: "avatars/../../secret", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cleanKey(tt.key)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidKey) {
+					t.Fatalf("cleanKey(%q): expected an invalid key, got %q, %v", tt.key, got, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Fatalf("cleanKey(%q): expected %q, got %q, %v", tt.key, tt.want, got, err)
+			}
+		})
+	}
+}
+
+func newTestFS(t *testing.T) (FS, string) {
+	t.Helper()
+
+	root := t.TempDir()
+	dir := filepath.Join(root, "files")
+
+	fs, err := NewFS(dir, "http://localhost/files/")
+	if err != nil {
+		t.Fatalf("NewFS: %v", err)
+	}
+
+	// a file beside the storage directory, which no key may reach
+	if err = os.WriteFile(filepath.Join(root, "secret"), []byte("secret"), 0o644); err != nil {
+		t.Fatalf("writing secret: %v", err)
+	}
+
+	return fs, root
+}
+
+func TestFSPutDeleteAll(t *testing.T) {
+	fs, root := newTestFS(t)
+	ctx := context.Background()
+
+	if err := fs.Put(ctx, "avatars/1/a/512.jpg", []byte("jpeg"), "image/jpeg"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if err := fs.Put(ctx, "avatars/1/a/64.jpg", []byte("jpeg"), "image/jpeg"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(root, "files", "avatars", "1", "a", "512.jpg"))
+	if err != nil || string(data) != "jpeg" {
+		t.Fatalf("expected the stored file, got %q, %v", data, err)
+	}
+	if url := fs.URL("avatars/1/a/512.jpg"); url != "http://localhost/files/avatars/1/a/512.jpg" {
+		t.Fatalf("unexpected url %q", url)
+	}
+
+	for _, key := range []string{"../secret", "avatars/../../secret", "/secret", ""} {
+		if err = fs.Put(ctx, key, []byte("overwritten"), "image/jpeg"); !errors.Is(err, ErrInvalidKey) {
+			t.Fatalf("Put(%q): expected an invalid key, got %v", key, err)
+		}
+		if err = fs.DeleteAll(ctx, key); !errors.Is(err, ErrInvalidKey) {
+			t.Fatalf("DeleteAll(%q): expected an invalid key, got %v", key, err)
+		}
+	}
+	if data, err = os.ReadFile(filepath.Join(root, "secret")); err != nil || string(data) != "secret" {
+		t.Fatalf("expected the file outside of storage to be untouched, got %q, %v", data, err)
+	}
+
+	if err = fs.DeleteAll(ctx, "avatars/1"); err != nil {
+		t.Fatalf("DeleteAll: %v", err)
+	}
+	if _, err = os.Stat(filepath.Join(root, "files", "avatars", "1")); !os.IsNotExist(err) {
+		t.Fatalf("expected the prefix to be deleted, got %v", err)
+	}
+	if err = fs.DeleteAll(ctx, "avatars/1"); err != nil {
+		t.Fatalf("DeleteAll of a missing prefix: %v", err)
+	}
+}
+
+func TestFSHandler(t *testing.T) {
+	fs, _ := newTestFS(t)
+
+	if err := fs.Put(context.Background(), "avatars/1/a/512.jpg", []byte("jpeg"), "image/jpeg"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+	}{
+		{name: "stored file", path: "/avatars/1/a/512.jpg", wantStatus: http.StatusOK},
+		{name: "missing file", path: "/avatars/1/a/64.jpg", wantStatus: http.StatusNotFound},
+		{name: "directory", path: "/avatars/1/", wantStatus: http.StatusNotFound},
+		{name: "root", path: "/", wantStatus: http.StatusNotFound},
+		{name: "outside of storage", path: "/../secret", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.URL.Path = tt.path
+
+			w := httptest.NewRecorder()
+			fs.Handler().ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus == http.StatusOK {
+				if w.Body.String() != "jpeg" {
+					t.Fatalf("expected the file content, got %q", w.Body.String())
+				}
+				if w.Header().Get("X-Content-Type-Options") != "nosniff" {
+					t.Fatalf("expected nosniff, got %q", w.Header().Get("X-Content-Type-Options"))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+)
+
+// Blob stores objects under slash separated keys and tells the public URL they are served from.
+// Keys are written once and never overwritten, so whatever serves them may cache forever.
+type Blob interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// DeleteAll removes every object whose key starts with the prefix, it is not an error if there are none.
+	DeleteAll(ctx context.Context, prefix string) error
+	URL(key string) string
+}
+
+var ErrInvalidKey = errors.New("invalid object key")
+
+// cleanKey rejects keys that are empty, absolute or escape the storage root.
+func cleanKey(key string) (string, error) {
+	clean := path.Clean(key)
+	if key == "" || clean == "." || strings.HasPrefix(clean, "/") || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", ErrInvalidKey
+	}
+
+	return clean, nil
+}
+
+func joinURL(baseURL, key string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/" + key
+}
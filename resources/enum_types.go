@@ -1,5 +1,7 @@
 package resources
 
 const (
-	ProfileType = "profile"
+	ProfileType       = "profile"
+	ProfilesBatchType = "profiles_batch"
+	InboxEventType    = "inbox_event"
 )
@@ -22,6 +22,7 @@ var _ MappedNullable = &Profile{}
 // Profile struct for Profile
 type Profile struct {
 	Data ProfileData `json:"data"`
+	Meta *ProfileMeta `json:"meta,omitempty"`
 }
 
 type _Profile Profile
@@ -68,6 +69,38 @@ func (o *Profile) SetData(v ProfileData) {
 	o.Data = v
 }
 
+// GetMeta returns the Meta field value if set, zero value otherwise.
+func (o *Profile) GetMeta() ProfileMeta {
+	if o == nil || IsNil(o.Meta) {
+		var ret ProfileMeta
+		return ret
+	}
+	return *o.Meta
+}
+
+// GetMetaOk returns a tuple with the Meta field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Profile) GetMetaOk() (*ProfileMeta, bool) {
+	if o == nil || IsNil(o.Meta) {
+		return nil, false
+	}
+	return o.Meta, true
+}
+
+// HasMeta returns a boolean if a field has been set.
+func (o *Profile) HasMeta() bool {
+	if o != nil && !IsNil(o.Meta) {
+		return true
+	}
+
+	return false
+}
+
+// SetMeta gets a reference to the given ProfileMeta and assigns it to the Meta field.
+func (o *Profile) SetMeta(v ProfileMeta) {
+	o.Meta = &v
+}
+
 func (o Profile) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -79,6 +112,9 @@ func (o Profile) MarshalJSON() ([]byte, error) {
 func (o Profile) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["data"] = o.Data
+	if !IsNil(o.Meta) {
+		toSerialize["meta"] = o.Meta
+	}
 	return toSerialize, nil
 }
 
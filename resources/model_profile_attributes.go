@@ -30,6 +30,16 @@ type ProfileAttributes struct {
 	Description *string `json:"description,omitempty"`
 	// Avatar URL
 	Avatar *string `json:"avatar,omitempty"`
+	// Generated placeholder avatar URL, present only when avatar is not set
+	DefaultAvatar *string `json:"default_avatar,omitempty"`
+	// Banner URL
+	Banner *string `json:"banner,omitempty"`
+	// Accent color as #rrggbb
+	AccentColor *string `json:"accent_color,omitempty"`
+	// Sex
+	Sex *string `json:"sex,omitempty"`
+	// Birth date
+	BirthDate *string `json:"birth_date,omitempty"`
 	// Is Official Account
 	Official bool `json:"official"`
 	// Updated At
@@ -181,6 +191,166 @@ func (o *ProfileAttributes) SetAvatar(v string) {
 	o.Avatar = &v
 }
 
+// GetDefaultAvatar returns the DefaultAvatar field value if set, zero value otherwise.
+func (o *ProfileAttributes) GetDefaultAvatar() string {
+	if o == nil || IsNil(o.DefaultAvatar) {
+		var ret string
+		return ret
+	}
+	return *o.DefaultAvatar
+}
+
+// GetDefaultAvatarOk returns a tuple with the DefaultAvatar field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProfileAttributes) GetDefaultAvatarOk() (*string, bool) {
+	if o == nil || IsNil(o.DefaultAvatar) {
+		return nil, false
+	}
+	return o.DefaultAvatar, true
+}
+
+// HasDefaultAvatar returns a boolean if a field has been set.
+func (o *ProfileAttributes) HasDefaultAvatar() bool {
+	if o != nil && !IsNil(o.DefaultAvatar) {
+		return true
+	}
+
+	return false
+}
+
+// SetDefaultAvatar gets a reference to the given string and assigns it to the DefaultAvatar field.
+func (o *ProfileAttributes) SetDefaultAvatar(v string) {
+	o.DefaultAvatar = &v
+}
+
+// GetBanner returns the Banner field value if set, zero value otherwise.
+func (o *ProfileAttributes) GetBanner() string {
+	if o == nil || IsNil(o.Banner) {
+		var ret string
+		return ret
+	}
+	return *o.Banner
+}
+
+// GetBannerOk returns a tuple with the Banner field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProfileAttributes) GetBannerOk() (*string, bool) {
+	if o == nil || IsNil(o.Banner) {
+		return nil, false
+	}
+	return o.Banner, true
+}
+
+// HasBanner returns a boolean if a field has been set.
+func (o *ProfileAttributes) HasBanner() bool {
+	if o != nil && !IsNil(o.Banner) {
+		return true
+	}
+
+	return false
+}
+
+// SetBanner gets a reference to the given string and assigns it to the Banner field.
+func (o *ProfileAttributes) SetBanner(v string) {
+	o.Banner = &v
+}
+
+// GetAccentColor returns the AccentColor field value if set, zero value otherwise.
+func (o *ProfileAttributes) GetAccentColor() string {
+	if o == nil || IsNil(o.AccentColor) {
+		var ret string
+		return ret
+	}
+	return *o.AccentColor
+}
+
+// GetAccentColorOk returns a tuple with the AccentColor field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProfileAttributes) GetAccentColorOk() (*string, bool) {
+	if o == nil || IsNil(o.AccentColor) {
+		return nil, false
+	}
+	return o.AccentColor, true
+}
+
+// HasAccentColor returns a boolean if a field has been set.
+func (o *ProfileAttributes) HasAccentColor() bool {
+	if o != nil && !IsNil(o.AccentColor) {
+		return true
+	}
+
+	return false
+}
+
+// SetAccentColor gets a reference to the given string and assigns it to the AccentColor field.
+func (o *ProfileAttributes) SetAccentColor(v string) {
+	o.AccentColor = &v
+}
+
+// GetSex returns the Sex field value if set, zero value otherwise.
+func (o *ProfileAttributes) GetSex() string {
+	if o == nil || IsNil(o.Sex) {
+		var ret string
+		return ret
+	}
+	return *o.Sex
+}
+
+// GetSexOk returns a tuple with the Sex field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProfileAttributes) GetSexOk() (*string, bool) {
+	if o == nil || IsNil(o.Sex) {
+		return nil, false
+	}
+	return o.Sex, true
+}
+
+// HasSex returns a boolean if a field has been set.
+func (o *ProfileAttributes) HasSex() bool {
+	if o != nil && !IsNil(o.Sex) {
+		return true
+	}
+
+	return false
+}
+
+// SetSex gets a reference to the given string and assigns it to the Sex field.
+func (o *ProfileAttributes) SetSex(v string) {
+	o.Sex = &v
+}
+
+// GetBirthDate returns the BirthDate field value if set, zero value otherwise.
+func (o *ProfileAttributes) GetBirthDate() string {
+	if o == nil || IsNil(o.BirthDate) {
+		var ret string
+		return ret
+	}
+	return *o.BirthDate
+}
+
+// GetBirthDateOk returns a tuple with the BirthDate field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProfileAttributes) GetBirthDateOk() (*string, bool) {
+	if o == nil || IsNil(o.BirthDate) {
+		return nil, false
+	}
+	return o.BirthDate, true
+}
+
+// HasBirthDate returns a boolean if a field has been set.
+func (o *ProfileAttributes) HasBirthDate() bool {
+	if o != nil && !IsNil(o.BirthDate) {
+		return true
+	}
+
+	return false
+}
+
+// SetBirthDate gets a reference to the given string and assigns it to the BirthDate field.
+func (o *ProfileAttributes) SetBirthDate(v string) {
+	o.BirthDate = &v
+}
+
 // GetOfficial returns the Official field value
 func (o *ProfileAttributes) GetOfficial() bool {
 	if o == nil {
@@ -273,6 +443,21 @@ func (o ProfileAttributes) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Avatar) {
 		toSerialize["avatar"] = o.Avatar
 	}
+	if !IsNil(o.DefaultAvatar) {
+		toSerialize["default_avatar"] = o.DefaultAvatar
+	}
+	if !IsNil(o.Banner) {
+		toSerialize["banner"] = o.Banner
+	}
+	if !IsNil(o.AccentColor) {
+		toSerialize["accent_color"] = o.AccentColor
+	}
+	if !IsNil(o.Sex) {
+		toSerialize["sex"] = o.Sex
+	}
+	if !IsNil(o.BirthDate) {
+		toSerialize["birth_date"] = o.BirthDate
+	}
 	toSerialize["official"] = o.Official
 	toSerialize["updated_at"] = o.UpdatedAt
 	toSerialize["created_at"] = o.CreatedAt
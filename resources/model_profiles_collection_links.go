@@ -21,12 +21,26 @@ var _ MappedNullable = &ProfilesCollectionLinks{}
 
 // ProfilesCollectionLinks struct for ProfilesCollectionLinks
 type ProfilesCollectionLinks struct {
-	// The current page number.
-	PageNumber int64 `json:"page_number"`
+	// The current page number, absent in cursor mode.
+	PageNumber *int64 `json:"page_number,omitempty"`
 	// The number of items per page.
 	PageSize int64 `json:"page_size"`
 	// The total number of items available.
 	TotalItems int64 `json:"total_items"`
+	// Link to the current page.
+	Self string `json:"self"`
+	// Link to the first page.
+	First string `json:"first"`
+	// Link to the last page, absent in cursor mode.
+	Last *string `json:"last,omitempty"`
+	// Link to the previous page, absent on the first page.
+	Prev *string `json:"prev,omitempty"`
+	// Link to the next page, absent on the last page.
+	Next *string `json:"next,omitempty"`
+	// Opaque cursor of the next page, pass it back as page[cursor]. Cursor mode only.
+	NextCursor *string `json:"next_cursor,omitempty"`
+	// Opaque cursor of the previous page, pass it back as page[cursor]. Cursor mode only.
+	PrevCursor *string `json:"prev_cursor,omitempty"`
 }
 
 type _ProfilesCollectionLinks ProfilesCollectionLinks
@@ -35,11 +49,12 @@ type _ProfilesCollectionLinks ProfilesCollectionLinks
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewProfilesCollectionLinks(pageNumber int64, pageSize int64, totalItems int64) *ProfilesCollectionLinks {
+func NewProfilesCollectionLinks(pageSize int64, totalItems int64, self string, first string) *ProfilesCollectionLinks {
 	this := ProfilesCollectionLinks{}
-	this.PageNumber = pageNumber
 	this.PageSize = pageSize
 	this.TotalItems = totalItems
+	this.Self = self
+	this.First = first
 	return &this
 }
 
@@ -51,28 +66,36 @@ func NewProfilesCollectionLinksWithDefaults() *ProfilesCollectionLinks {
 	return &this
 }
 
-// GetPageNumber returns the PageNumber field value
+// GetPageNumber returns the PageNumber field value if set, zero value otherwise.
 func (o *ProfilesCollectionLinks) GetPageNumber() int64 {
-	if o == nil {
+	if o == nil || IsNil(o.PageNumber) {
 		var ret int64
 		return ret
 	}
-
-	return o.PageNumber
+	return *o.PageNumber
 }
 
-// GetPageNumberOk returns a tuple with the PageNumber field value
+// GetPageNumberOk returns a tuple with the PageNumber field value if set, nil otherwise
 // and a boolean to check if the value has been set.
 func (o *ProfilesCollectionLinks) GetPageNumberOk() (*int64, bool) {
-	if o == nil {
+	if o == nil || IsNil(o.PageNumber) {
 		return nil, false
 	}
-	return &o.PageNumber, true
+	return o.PageNumber, true
+}
+
+// HasPageNumber returns a boolean if a field has been set.
+func (o *ProfilesCollectionLinks) HasPageNumber() bool {
+	if o != nil && !IsNil(o.PageNumber) {
+		return true
+	}
+
+	return false
 }
 
-// SetPageNumber sets field value
+// SetPageNumber gets a reference to the given int64 and assigns it to the PageNumber field.
 func (o *ProfilesCollectionLinks) SetPageNumber(v int64) {
-	o.PageNumber = v
+	o.PageNumber = &v
 }
 
 // GetPageSize returns the PageSize field value
@@ -123,6 +146,214 @@ func (o *ProfilesCollectionLinks) SetTotalItems(v int64) {
 	o.TotalItems = v
 }
 
+// GetSelf returns the Self field value
+func (o *ProfilesCollectionLinks) GetSelf() string {
+	if o == nil {
+		var ret string
+		return ret
+	}
+
+	return o.Self
+}
+
+// GetSelfOk returns a tuple with the Self field value
+// and a boolean to check if the value has been set.
+func (o *ProfilesCollectionLinks) GetSelfOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Self, true
+}
+
+// SetSelf sets field value
+func (o *ProfilesCollectionLinks) SetSelf(v string) {
+	o.Self = v
+}
+
+// GetFirst returns the First field value
+func (o *ProfilesCollectionLinks) GetFirst() string {
+	if o == nil {
+		var ret string
+		return ret
+	}
+
+	return o.First
+}
+
+// GetFirstOk returns a tuple with the First field value
+// and a boolean to check if the value has been set.
+func (o *ProfilesCollectionLinks) GetFirstOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.First, true
+}
+
+// SetFirst sets field value
+func (o *ProfilesCollectionLinks) SetFirst(v string) {
+	o.First = v
+}
+
+// GetLast returns the Last field value if set, zero value otherwise.
+func (o *ProfilesCollectionLinks) GetLast() string {
+	if o == nil || IsNil(o.Last) {
+		var ret string
+		return ret
+	}
+	return *o.Last
+}
+
+// GetLastOk returns a tuple with the Last field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProfilesCollectionLinks) GetLastOk() (*string, bool) {
+	if o == nil || IsNil(o.Last) {
+		return nil, false
+	}
+	return o.Last, true
+}
+
+// HasLast returns a boolean if a field has been set.
+func (o *ProfilesCollectionLinks) HasLast() bool {
+	if o != nil && !IsNil(o.Last) {
+		return true
+	}
+
+	return false
+}
+
+// SetLast gets a reference to the given string and assigns it to the Last field.
+func (o *ProfilesCollectionLinks) SetLast(v string) {
+	o.Last = &v
+}
+
+// GetPrev returns the Prev field value if set, zero value otherwise.
+func (o *ProfilesCollectionLinks) GetPrev() string {
+	if o == nil || IsNil(o.Prev) {
+		var ret string
+		return ret
+	}
+	return *o.Prev
+}
+
+// GetPrevOk returns a tuple with the Prev field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProfilesCollectionLinks) GetPrevOk() (*string, bool) {
+	if o == nil || IsNil(o.Prev) {
+		return nil, false
+	}
+	return o.Prev, true
+}
+
+// HasPrev returns a boolean if a field has been set.
+func (o *ProfilesCollectionLinks) HasPrev() bool {
+	if o != nil && !IsNil(o.Prev) {
+		return true
+	}
+
+	return false
+}
+
+// SetPrev gets a reference to the given string and assigns it to the Prev field.
+func (o *ProfilesCollectionLinks) SetPrev(v string) {
+	o.Prev = &v
+}
+
+// GetNext returns the Next field value if set, zero value otherwise.
+func (o *ProfilesCollectionLinks) GetNext() string {
+	if o == nil || IsNil(o.Next) {
+		var ret string
+		return ret
+	}
+	return *o.Next
+}
+
+// GetNextOk returns a tuple with the Next field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProfilesCollectionLinks) GetNextOk() (*string, bool) {
+	if o == nil || IsNil(o.Next) {
+		return nil, false
+	}
+	return o.Next, true
+}
+
+// HasNext returns a boolean if a field has been set.
+func (o *ProfilesCollectionLinks) HasNext() bool {
+	if o != nil && !IsNil(o.Next) {
+		return true
+	}
+
+	return false
+}
+
+// SetNext gets a reference to the given string and assigns it to the Next field.
+func (o *ProfilesCollectionLinks) SetNext(v string) {
+	o.Next = &v
+}
+
+// GetNextCursor returns the NextCursor field value if set, zero value otherwise.
+func (o *ProfilesCollectionLinks) GetNextCursor() string {
+	if o == nil || IsNil(o.NextCursor) {
+		var ret string
+		return ret
+	}
+	return *o.NextCursor
+}
+
+// GetNextCursorOk returns a tuple with the NextCursor field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProfilesCollectionLinks) GetNextCursorOk() (*string, bool) {
+	if o == nil || IsNil(o.NextCursor) {
+		return nil, false
+	}
+	return o.NextCursor, true
+}
+
+// HasNextCursor returns a boolean if a field has been set.
+func (o *ProfilesCollectionLinks) HasNextCursor() bool {
+	if o != nil && !IsNil(o.NextCursor) {
+		return true
+	}
+
+	return false
+}
+
+// SetNextCursor gets a reference to the given string and assigns it to the NextCursor field.
+func (o *ProfilesCollectionLinks) SetNextCursor(v string) {
+	o.NextCursor = &v
+}
+
+// GetPrevCursor returns the PrevCursor field value if set, zero value otherwise.
+func (o *ProfilesCollectionLinks) GetPrevCursor() string {
+	if o == nil || IsNil(o.PrevCursor) {
+		var ret string
+		return ret
+	}
+	return *o.PrevCursor
+}
+
+// GetPrevCursorOk returns a tuple with the PrevCursor field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProfilesCollectionLinks) GetPrevCursorOk() (*string, bool) {
+	if o == nil || IsNil(o.PrevCursor) {
+		return nil, false
+	}
+	return o.PrevCursor, true
+}
+
+// HasPrevCursor returns a boolean if a field has been set.
+func (o *ProfilesCollectionLinks) HasPrevCursor() bool {
+	if o != nil && !IsNil(o.PrevCursor) {
+		return true
+	}
+
+	return false
+}
+
+// SetPrevCursor gets a reference to the given string and assigns it to the PrevCursor field.
+func (o *ProfilesCollectionLinks) SetPrevCursor(v string) {
+	o.PrevCursor = &v
+}
+
 func (o ProfilesCollectionLinks) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -133,9 +364,28 @@ func (o ProfilesCollectionLinks) MarshalJSON() ([]byte, error) {
 
 func (o ProfilesCollectionLinks) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
-	toSerialize["page_number"] = o.PageNumber
+	if !IsNil(o.PageNumber) {
+		toSerialize["page_number"] = o.PageNumber
+	}
 	toSerialize["page_size"] = o.PageSize
 	toSerialize["total_items"] = o.TotalItems
+	toSerialize["self"] = o.Self
+	toSerialize["first"] = o.First
+	if !IsNil(o.Last) {
+		toSerialize["last"] = o.Last
+	}
+	if !IsNil(o.Prev) {
+		toSerialize["prev"] = o.Prev
+	}
+	if !IsNil(o.Next) {
+		toSerialize["next"] = o.Next
+	}
+	if !IsNil(o.NextCursor) {
+		toSerialize["next_cursor"] = o.NextCursor
+	}
+	if !IsNil(o.PrevCursor) {
+		toSerialize["prev_cursor"] = o.PrevCursor
+	}
 	return toSerialize, nil
 }
 
@@ -144,9 +394,10 @@ func (o *ProfilesCollectionLinks) UnmarshalJSON(data []byte) (err error) {
 	// by unmarshalling the object into a generic map with string keys and checking
 	// that every required field exists as a key in the generic map.
 	requiredProperties := []string{
-		"page_number",
 		"page_size",
 		"total_items",
+		"self",
+		"first",
 	}
 
 	allProperties := make(map[string]interface{})
@@ -20,11 +20,19 @@ var _ MappedNullable = &UpdateProfileDataAttributes{}
 // UpdateProfileDataAttributes struct for UpdateProfileDataAttributes
 type UpdateProfileDataAttributes struct {
 	// Pseudonym
-	Pseudonym *string `json:"pseudonym,omitempty"`
+	Pseudonym NullableString `json:"pseudonym,omitempty"`
 	// Description
-	Description *string `json:"description,omitempty"`
-	// Avatar URL
-	Avatar *string `json:"avatar,omitempty"`
+	Description NullableString `json:"description,omitempty"`
+	// Avatar URL issued by an avatar upload, other URLs only if external avatars are allowed
+	Avatar NullableString `json:"avatar,omitempty"`
+	// Banner image URL, absolute http or https
+	Banner NullableString `json:"banner,omitempty"`
+	// Accent color as #RRGGBB
+	AccentColor NullableString `json:"accent_color,omitempty"`
+	// Sex
+	Sex NullableString `json:"sex,omitempty"`
+	// Birth date
+	BirthDate NullableString `json:"birth_date,omitempty"`
 }
 
 // NewUpdateProfileDataAttributes instantiates a new UpdateProfileDataAttributes object
@@ -44,100 +52,298 @@ func NewUpdateProfileDataAttributesWithDefaults() *UpdateProfileDataAttributes {
 	return &this
 }
 
-// GetPseudonym returns the Pseudonym field value if set, zero value otherwise.
+// GetPseudonym returns the Pseudonym field value if set, zero value otherwise (both if not set or set to explicit null).
 func (o *UpdateProfileDataAttributes) GetPseudonym() string {
-	if o == nil || IsNil(o.Pseudonym) {
+	if o == nil || IsNil(o.Pseudonym.Get()) {
 		var ret string
 		return ret
 	}
-	return *o.Pseudonym
+	return *o.Pseudonym.Get()
 }
 
 // GetPseudonymOk returns a tuple with the Pseudonym field value if set, nil otherwise
 // and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
 func (o *UpdateProfileDataAttributes) GetPseudonymOk() (*string, bool) {
-	if o == nil || IsNil(o.Pseudonym) {
+	if o == nil {
 		return nil, false
 	}
-	return o.Pseudonym, true
+	return o.Pseudonym.Get(), o.Pseudonym.IsSet()
 }
 
 // HasPseudonym returns a boolean if a field has been set.
 func (o *UpdateProfileDataAttributes) HasPseudonym() bool {
-	if o != nil && !IsNil(o.Pseudonym) {
+	if o != nil && o.Pseudonym.IsSet() {
 		return true
 	}
 
 	return false
 }
 
-// SetPseudonym gets a reference to the given string and assigns it to the Pseudonym field.
+// SetPseudonym gets a reference to the given NullableString and assigns it to the Pseudonym field.
 func (o *UpdateProfileDataAttributes) SetPseudonym(v string) {
-	o.Pseudonym = &v
+	o.Pseudonym.Set(&v)
+}
+// SetPseudonymNil sets the value for Pseudonym to be an explicit nil
+func (o *UpdateProfileDataAttributes) SetPseudonymNil() {
+	o.Pseudonym.Set(nil)
+}
+
+// UnsetPseudonym ensures that no value is present for Pseudonym, not even an explicit nil
+func (o *UpdateProfileDataAttributes) UnsetPseudonym() {
+	o.Pseudonym.Unset()
 }
 
-// GetDescription returns the Description field value if set, zero value otherwise.
+// GetDescription returns the Description field value if set, zero value otherwise (both if not set or set to explicit null).
 func (o *UpdateProfileDataAttributes) GetDescription() string {
-	if o == nil || IsNil(o.Description) {
+	if o == nil || IsNil(o.Description.Get()) {
 		var ret string
 		return ret
 	}
-	return *o.Description
+	return *o.Description.Get()
 }
 
 // GetDescriptionOk returns a tuple with the Description field value if set, nil otherwise
 // and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
 func (o *UpdateProfileDataAttributes) GetDescriptionOk() (*string, bool) {
-	if o == nil || IsNil(o.Description) {
+	if o == nil {
 		return nil, false
 	}
-	return o.Description, true
+	return o.Description.Get(), o.Description.IsSet()
 }
 
 // HasDescription returns a boolean if a field has been set.
 func (o *UpdateProfileDataAttributes) HasDescription() bool {
-	if o != nil && !IsNil(o.Description) {
+	if o != nil && o.Description.IsSet() {
 		return true
 	}
 
 	return false
 }
 
-// SetDescription gets a reference to the given string and assigns it to the Description field.
+// SetDescription gets a reference to the given NullableString and assigns it to the Description field.
 func (o *UpdateProfileDataAttributes) SetDescription(v string) {
-	o.Description = &v
+	o.Description.Set(&v)
+}
+// SetDescriptionNil sets the value for Description to be an explicit nil
+func (o *UpdateProfileDataAttributes) SetDescriptionNil() {
+	o.Description.Set(nil)
 }
 
-// GetAvatar returns the Avatar field value if set, zero value otherwise.
+// UnsetDescription ensures that no value is present for Description, not even an explicit nil
+func (o *UpdateProfileDataAttributes) UnsetDescription() {
+	o.Description.Unset()
+}
+
+// GetAvatar returns the Avatar field value if set, zero value otherwise (both if not set or set to explicit null).
 func (o *UpdateProfileDataAttributes) GetAvatar() string {
-	if o == nil || IsNil(o.Avatar) {
+	if o == nil || IsNil(o.Avatar.Get()) {
 		var ret string
 		return ret
 	}
-	return *o.Avatar
+	return *o.Avatar.Get()
 }
 
 // GetAvatarOk returns a tuple with the Avatar field value if set, nil otherwise
 // and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
 func (o *UpdateProfileDataAttributes) GetAvatarOk() (*string, bool) {
-	if o == nil || IsNil(o.Avatar) {
+	if o == nil {
 		return nil, false
 	}
-	return o.Avatar, true
+	return o.Avatar.Get(), o.Avatar.IsSet()
 }
 
 // HasAvatar returns a boolean if a field has been set.
 func (o *UpdateProfileDataAttributes) HasAvatar() bool {
-	if o != nil && !IsNil(o.Avatar) {
+	if o != nil && o.Avatar.IsSet() {
 		return true
 	}
 
 	return false
 }
 
-// SetAvatar gets a reference to the given string and assigns it to the Avatar field.
+// SetAvatar gets a reference to the given NullableString and assigns it to the Avatar field.
 func (o *UpdateProfileDataAttributes) SetAvatar(v string) {
-	o.Avatar = &v
+	o.Avatar.Set(&v)
+}
+// SetAvatarNil sets the value for Avatar to be an explicit nil
+func (o *UpdateProfileDataAttributes) SetAvatarNil() {
+	o.Avatar.Set(nil)
+}
+
+// UnsetAvatar ensures that no value is present for Avatar, not even an explicit nil
+func (o *UpdateProfileDataAttributes) UnsetAvatar() {
+	o.Avatar.Unset()
+}
+
+// GetBanner returns the Banner field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *UpdateProfileDataAttributes) GetBanner() string {
+	if o == nil || IsNil(o.Banner.Get()) {
+		var ret string
+		return ret
+	}
+	return *o.Banner.Get()
+}
+
+// GetBannerOk returns a tuple with the Banner field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *UpdateProfileDataAttributes) GetBannerOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return o.Banner.Get(), o.Banner.IsSet()
+}
+
+// HasBanner returns a boolean if a field has been set.
+func (o *UpdateProfileDataAttributes) HasBanner() bool {
+	if o != nil && o.Banner.IsSet() {
+		return true
+	}
+
+	return false
+}
+
+// SetBanner gets a reference to the given NullableString and assigns it to the Banner field.
+func (o *UpdateProfileDataAttributes) SetBanner(v string) {
+	o.Banner.Set(&v)
+}
+// SetBannerNil sets the value for Banner to be an explicit nil
+func (o *UpdateProfileDataAttributes) SetBannerNil() {
+	o.Banner.Set(nil)
+}
+
+// UnsetBanner ensures that no value is present for Banner, not even an explicit nil
+func (o *UpdateProfileDataAttributes) UnsetBanner() {
+	o.Banner.Unset()
+}
+
+// GetAccentColor returns the AccentColor field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *UpdateProfileDataAttributes) GetAccentColor() string {
+	if o == nil || IsNil(o.AccentColor.Get()) {
+		var ret string
+		return ret
+	}
+	return *o.AccentColor.Get()
+}
+
+// GetAccentColorOk returns a tuple with the AccentColor field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *UpdateProfileDataAttributes) GetAccentColorOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return o.AccentColor.Get(), o.AccentColor.IsSet()
+}
+
+// HasAccentColor returns a boolean if a field has been set.
+func (o *UpdateProfileDataAttributes) HasAccentColor() bool {
+	if o != nil && o.AccentColor.IsSet() {
+		return true
+	}
+
+	return false
+}
+
+// SetAccentColor gets a reference to the given NullableString and assigns it to the AccentColor field.
+func (o *UpdateProfileDataAttributes) SetAccentColor(v string) {
+	o.AccentColor.Set(&v)
+}
+// SetAccentColorNil sets the value for AccentColor to be an explicit nil
+func (o *UpdateProfileDataAttributes) SetAccentColorNil() {
+	o.AccentColor.Set(nil)
+}
+
+// UnsetAccentColor ensures that no value is present for AccentColor, not even an explicit nil
+func (o *UpdateProfileDataAttributes) UnsetAccentColor() {
+	o.AccentColor.Unset()
+}
+
+// GetSex returns the Sex field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *UpdateProfileDataAttributes) GetSex() string {
+	if o == nil || IsNil(o.Sex.Get()) {
+		var ret string
+		return ret
+	}
+	return *o.Sex.Get()
+}
+
+// GetSexOk returns a tuple with the Sex field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *UpdateProfileDataAttributes) GetSexOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return o.Sex.Get(), o.Sex.IsSet()
+}
+
+// HasSex returns a boolean if a field has been set.
+func (o *UpdateProfileDataAttributes) HasSex() bool {
+	if o != nil && o.Sex.IsSet() {
+		return true
+	}
+
+	return false
+}
+
+// SetSex gets a reference to the given NullableString and assigns it to the Sex field.
+func (o *UpdateProfileDataAttributes) SetSex(v string) {
+	o.Sex.Set(&v)
+}
+// SetSexNil sets the value for Sex to be an explicit nil
+func (o *UpdateProfileDataAttributes) SetSexNil() {
+	o.Sex.Set(nil)
+}
+
+// UnsetSex ensures that no value is present for Sex, not even an explicit nil
+func (o *UpdateProfileDataAttributes) UnsetSex() {
+	o.Sex.Unset()
+}
+
+// GetBirthDate returns the BirthDate field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *UpdateProfileDataAttributes) GetBirthDate() string {
+	if o == nil || IsNil(o.BirthDate.Get()) {
+		var ret string
+		return ret
+	}
+	return *o.BirthDate.Get()
+}
+
+// GetBirthDateOk returns a tuple with the BirthDate field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *UpdateProfileDataAttributes) GetBirthDateOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return o.BirthDate.Get(), o.BirthDate.IsSet()
+}
+
+// HasBirthDate returns a boolean if a field has been set.
+func (o *UpdateProfileDataAttributes) HasBirthDate() bool {
+	if o != nil && o.BirthDate.IsSet() {
+		return true
+	}
+
+	return false
+}
+
+// SetBirthDate gets a reference to the given NullableString and assigns it to the BirthDate field.
+func (o *UpdateProfileDataAttributes) SetBirthDate(v string) {
+	o.BirthDate.Set(&v)
+}
+// SetBirthDateNil sets the value for BirthDate to be an explicit nil
+func (o *UpdateProfileDataAttributes) SetBirthDateNil() {
+	o.BirthDate.Set(nil)
+}
+
+// UnsetBirthDate ensures that no value is present for BirthDate, not even an explicit nil
+func (o *UpdateProfileDataAttributes) UnsetBirthDate() {
+	o.BirthDate.Unset()
 }
 
 func (o UpdateProfileDataAttributes) MarshalJSON() ([]byte, error) {
@@ -150,14 +356,26 @@ func (o UpdateProfileDataAttributes) MarshalJSON() ([]byte, error) {
 
 func (o UpdateProfileDataAttributes) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
-	if !IsNil(o.Pseudonym) {
-		toSerialize["pseudonym"] = o.Pseudonym
+	if o.Pseudonym.IsSet() {
+		toSerialize["pseudonym"] = o.Pseudonym.Get()
+	}
+	if o.Description.IsSet() {
+		toSerialize["description"] = o.Description.Get()
+	}
+	if o.Avatar.IsSet() {
+		toSerialize["avatar"] = o.Avatar.Get()
+	}
+	if o.Banner.IsSet() {
+		toSerialize["banner"] = o.Banner.Get()
+	}
+	if o.AccentColor.IsSet() {
+		toSerialize["accent_color"] = o.AccentColor.Get()
 	}
-	if !IsNil(o.Description) {
-		toSerialize["description"] = o.Description
+	if o.Sex.IsSet() {
+		toSerialize["sex"] = o.Sex.Get()
 	}
-	if !IsNil(o.Avatar) {
-		toSerialize["avatar"] = o.Avatar
+	if o.BirthDate.IsSet() {
+		toSerialize["birth_date"] = o.BirthDate.Get()
 	}
 	return toSerialize, nil
 }
@@ -0,0 +1,61 @@
+package domain_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/test"
+)
+
+func TestClaimExpiredLeaseCountsAttempt(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+
+	test.CleanDb(t)
+
+	db, err := sql.Open("postgres", testDatabaseURL)
+	if err != nil {
+		t.Fatalf("sql.Open: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	id := uuid.New()
+
+	mustExec(t, db, `
+		INSERT INTO inbox_events (id, topic, key, type, version, producer, payload)
+		VALUES ($1, 'accounts.v1', $2, 'account.deleted', 1, 'accounts-svc', '{}')`,
+		id, uuid.NewString(),
+	)
+
+	const lease = 50 * time.Millisecond
+
+	for want := int32(0); want < 3; want++ {
+		claimed, err := s.repo.ClaimInboxEvents(ctx, 10, lease)
+		if err != nil {
+			t.Fatalf("ClaimInboxEvents %d: %v", want, err)
+		}
+		if len(claimed) != 1 || claimed[0].ID != id {
+			t.Fatalf("ClaimInboxEvents %d: expected event %s, got %d events", want, id, len(claimed))
+		}
+		if claimed[0].Attempts != want {
+			t.Fatalf("ClaimInboxEvents %d: expected %d attempts, got %d", want, want, claimed[0].Attempts)
+		}
+
+		claimed, err = s.repo.ClaimInboxEvents(ctx, 10, lease)
+		if err != nil {
+			t.Fatalf("ClaimInboxEvents %d while leased: %v", want, err)
+		}
+		if len(claimed) != 0 {
+			t.Fatalf("ClaimInboxEvents %d while leased: expected nothing, got %d events", want, len(claimed))
+		}
+
+		// let the lease expire as if the worker crashed
+		time.Sleep(2 * lease)
+	}
+}
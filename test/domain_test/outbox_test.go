@@ -0,0 +1,81 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/events/contracts"
+	"github.com/umisto/profiles-svc/test"
+)
+
+func TestPendingOutboxKeyOrder(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+
+	test.CleanDb(t)
+
+	ctx := context.Background()
+
+	create := func(key string) contracts.OutboxEvent {
+		t.Helper()
+
+		ev, err := s.repo.CreateOutboxEvent(ctx, contracts.OutboxEvent{
+			ID:       uuid.New(),
+			Topic:    "profiles.v1",
+			Key:      key,
+			Type:     "profile.updated",
+			Version:  1,
+			Producer: "profiles-svc",
+			Payload:  []byte(`{}`),
+		})
+		if err != nil {
+			t.Fatalf("CreateOutboxEvent %s: %v", key, err)
+		}
+		// keep creation times apart, events of a key are ordered by them
+		time.Sleep(time.Millisecond)
+
+		return ev
+	}
+
+	pending := func(name string, want ...contracts.OutboxEvent) {
+		t.Helper()
+
+		got, err := s.repo.GetPendingOutboxEvents(ctx, 10)
+		if err != nil {
+			t.Fatalf("GetPendingOutboxEvents %s: %v", name, err)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("GetPendingOutboxEvents %s: expected %d events, got %d", name, len(want), len(got))
+		}
+		for i := range want {
+			if got[i].ID != want[i].ID {
+				t.Fatalf("GetPendingOutboxEvents %s: event %d: expected %s, got %s", name, i, want[i].ID, got[i].ID)
+			}
+		}
+	}
+
+	firstA := create("a")
+	create("a")
+	firstB := create("b")
+
+	pending("first of every key", firstA, firstB)
+
+	if _, err = s.repo.MarkOutboxEventsAsPending(ctx, []uuid.UUID{firstA.ID}, time.Hour); err != nil {
+		t.Fatalf("MarkOutboxEventsAsPending: %v", err)
+	}
+	pending("retry scheduled", firstB)
+
+	if _, err = s.repo.MarkOutboxEventsAsFailed(ctx, []uuid.UUID{firstA.ID}); err != nil {
+		t.Fatalf("MarkOutboxEventsAsFailed: %v", err)
+	}
+	pending("failed holds its key", firstB)
+
+	if _, err = s.repo.MarkOutboxEventsAsSent(ctx, []uuid.UUID{firstB.ID}); err != nil {
+		t.Fatalf("MarkOutboxEventsAsSent: %v", err)
+	}
+	pending("all held or sent")
+}
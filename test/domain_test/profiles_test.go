@@ -2,9 +2,12 @@ package domain_test
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/umisto/profiles-svc/internal/domain/errx"
 	"github.com/umisto/profiles-svc/internal/domain/modules/profile"
 	"github.com/umisto/profiles-svc/test"
 )
@@ -22,18 +25,26 @@ func TestProfiles(t *testing.T) {
 	firstID := uuid.New()
 	secondID := uuid.New()
 
-	first, err := s.domain.profile.Create(ctx, firstID, "first")
+	first, err := s.domain.profile.CreateProfile(ctx, firstID, "first", time.Time{}, false)
 	if err != nil {
 		t.Fatalf("CreateProfile first: %v", err)
 	}
 
-	second, err := s.domain.profile.Create(ctx, secondID, "second")
+	second, err := s.domain.profile.CreateProfile(ctx, secondID, "second", time.Time{}, false)
 	if err != nil {
 		t.Fatalf("CreateProfile second: %v", err)
 	}
 
-	if first.UserID == second.UserID {
-		t.Fatalf("expected different IDs, got same: %v", first.UserID)
+	if first.AccountID == second.AccountID {
+		t.Fatalf("expected different IDs, got same: %v", first.AccountID)
+	}
+
+	again, err := s.domain.profile.CreateProfile(ctx, firstID, "first", time.Time{}, false)
+	if err != nil {
+		t.Fatalf("CreateProfile first again: %v", err)
+	}
+	if again.AccountID != firstID || again.Username != "first" {
+		t.Fatalf("CreateProfile first again: expected existing profile, got %+v", again)
 	}
 
 	first, err = s.domain.profile.GetProfileByID(ctx, firstID)
@@ -41,8 +52,8 @@ func TestProfiles(t *testing.T) {
 		t.Fatalf("GetProfileByAccountID first: %v", err)
 	}
 
-	if first.UserID != firstID {
-		t.Fatalf("GetProfileByAccountID first: expected ID %v, got %v", firstID, first.UserID)
+	if first.AccountID != firstID {
+		t.Fatalf("GetProfileByAccountID first: expected ID %v, got %v", firstID, first.AccountID)
 	}
 
 	second, err = s.domain.profile.GetProfileByID(ctx, secondID)
@@ -50,18 +61,18 @@ func TestProfiles(t *testing.T) {
 		t.Fatalf("GetProfileByAccountID second: %v", err)
 	}
 
-	if second.UserID != secondID {
-		t.Fatalf("GetProfileByAccountID second: expected ID %v, got %v", secondID, second.UserID)
+	if second.AccountID != secondID {
+		t.Fatalf("GetProfileByAccountID second: expected ID %v, got %v", secondID, second.AccountID)
 	}
 
 	avatar := "avatar"
 	newFirst := "new_first"
 	description := "description"
 
-	first, err = s.domain.profile.UpdateProfile(ctx, firstID, domain2.Update{
-		Avatar:      &avatar,
-		Pseudonym:   &newFirst,
-		Description: &description,
+	first, err = s.domain.profile.UpdateProfile(ctx, firstID, profile.UpdateParams{
+		Avatar:      profile.SetField(avatar),
+		Pseudonym:   profile.SetField(newFirst),
+		Description: profile.SetField(description),
 	})
 	if err != nil {
 		t.Fatalf("UpdateProfile first: %v", err)
@@ -77,7 +88,25 @@ func TestProfiles(t *testing.T) {
 		t.Fatalf("UpdateProfile first: expected description %s, got %s", description, *first.Description)
 	}
 
-	second, err = s.domain.profile.ResetProfile(ctx, secondID)
+	first, err = s.domain.profile.UpdateProfile(ctx, firstID, profile.UpdateParams{
+		Description: profile.ClearField[string](),
+	})
+	if err != nil {
+		t.Fatalf("UpdateProfile clear first: %v", err)
+	}
+	if first.Description != nil {
+		t.Fatalf("UpdateProfile clear first: expected description nil, got %s", *first.Description)
+	}
+	if first.Pseudonym == nil || *first.Pseudonym != newFirst {
+		t.Fatalf("UpdateProfile clear first: expected pseudonym %s to be kept", newFirst)
+	}
+
+	moderID := uuid.New()
+
+	second, err = s.domain.profile.ResetProfile(ctx, secondID, profile.ResetParams{
+		InitiatorID: moderID,
+		Reason:      "abusive content",
+	})
 	if err != nil {
 		t.Fatalf("ResetUserProfile second: %v", err)
 	}
@@ -91,7 +120,11 @@ func TestProfiles(t *testing.T) {
 		t.Fatalf("ResetUserProfile second: expected description nil, got %v", *second.Description)
 	}
 
-	first, err = s.domain.profile.ResetProfile(ctx, firstID)
+	first, err = s.domain.profile.ResetProfile(ctx, firstID, profile.ResetParams{
+		InitiatorID:   moderID,
+		Reason:        "abusive username",
+		ResetUsername: true,
+	})
 	if err != nil {
 		t.Fatalf("ResetUsername first: %v", err)
 	}
@@ -99,6 +132,14 @@ func TestProfiles(t *testing.T) {
 		t.Fatalf("ResetUsername first: expected username not %s, got %s", "first", first.Username)
 	}
 
+	retired, err := s.domain.profile.GetProfileByUsername(ctx, "first")
+	if err != nil {
+		t.Fatalf("GetProfileByUsername reset: %v", err)
+	}
+	if retired.AccountID != firstID || retired.Username != first.Username {
+		t.Fatalf("GetProfileByUsername reset: expected %s as %s, got %s as %s", firstID, first.Username, retired.AccountID, retired.Username)
+	}
+
 	first, err = s.domain.profile.UpdateProfileOfficial(ctx, firstID, false)
 	if err != nil {
 		t.Fatalf("UpdateProfileOfficial first to false: %v", err)
@@ -117,54 +158,218 @@ func TestProfiles(t *testing.T) {
 		t.Fatalf("UpdateProfileOfficial first to true: expected official true, got false")
 	}
 
-	list, err := s.domain.profile.Filter(ctx, profile.FilterParams{
-		UserID: []uuid.UUID{firstID, secondID},
-	}, 0, 10)
+	list, err := s.domain.profile.FilterProfile(ctx, profile.FilterParams{}, 0, 10)
 	if err != nil {
-		t.Fatalf("FilterProfiles by IDs: %v", err)
+		t.Fatalf("FilterProfiles: %v", err)
 	}
 	if len(list.Data) != 2 {
-		t.Fatalf("FilterProfiles by IDs: expected 2 profiles, got %d", len(list.Data))
+		t.Fatalf("FilterProfiles: expected 2 profiles, got %d", len(list.Data))
 	}
 
-	first, err = s.domain.profile.UpdateProfileUsername(ctx, firstID, "first")
+	first, err = s.domain.profile.UpdateProfileUsername(ctx, firstID, "first", time.Time{})
 	if err != nil {
 		t.Fatalf("UpdateProfileUsername first: %v", err)
 	}
-	first, err = s.domain.profile.UpdateProfile(ctx, firstID, domain2.Update{
-		Avatar:      func() *string { s := "avatar"; return &s }(),
-		Pseudonym:   func() *string { s := "new_first"; return &s }(),
-		Description: func() *string { s := "first description"; return &s }(),
+	first, err = s.domain.profile.UpdateProfile(ctx, firstID, profile.UpdateParams{
+		Avatar:      profile.SetField("avatar"),
+		Pseudonym:   profile.SetField("new_first"),
+		Description: profile.SetField("first description"),
 	})
 
-	second, err = s.domain.profile.UpdateProfileUsername(ctx, secondID, "second")
+	second, err = s.domain.profile.UpdateProfileUsername(ctx, secondID, "second", time.Time{})
 	if err != nil {
 		t.Fatalf("UpdateProfileUsername second: %v", err)
 	}
-	second, err = s.domain.profile.UpdateProfile(ctx, secondID, domain2.Update{
-		Avatar:      func() *string { s := "avatar2"; return &s }(),
-		Pseudonym:   func() *string { s := "new_second"; return &s }(),
-		Description: func() *string { s := "second description"; return &s }(),
+	second, err = s.domain.profile.UpdateProfile(ctx, secondID, profile.UpdateParams{
+		Avatar:      profile.SetField("avatar2"),
+		Pseudonym:   profile.SetField("new_second"),
+		Description: profile.SetField("second description"),
 	})
 
-	third, err := s.domain.profile.Create(ctx, uuid.New(), "third")
+	third, err := s.domain.profile.CreateProfile(ctx, uuid.New(), "third", time.Time{}, false)
 	if err != nil {
 		t.Fatalf("CreateProfile third: %v", err)
 	}
-	third, err = s.domain.profile.UpdateProfile(ctx, third.UserID, domain2.Update{
-		Avatar:      func() *string { s := "avatar3"; return &s }(),
-		Pseudonym:   func() *string { s := "new_third"; return &s }(),
-		Description: func() *string { s := "third description"; return &s }(),
+	third, err = s.domain.profile.UpdateProfile(ctx, third.AccountID, profile.UpdateParams{
+		Avatar:      profile.SetField("avatar3"),
+		Pseudonym:   profile.SetField("new_third"),
+		Description: profile.SetField("third description"),
 	})
 	if err != nil {
 		t.Fatalf("UpdateProfile third: %v", err)
 	}
 
-	list, err = s.domain.profile.Filter(ctx, profile.FilterParams{}, 0, 10)
+	list, err = s.domain.profile.FilterProfile(ctx, profile.FilterParams{}, 0, 10)
 	if err != nil {
 		t.Fatalf("FilterProfiles all: %v", err)
 	}
 	if len(list.Data) != 3 {
 		t.Fatalf("FilterProfiles all: expected 3 profiles, got %d", len(list.Data))
 	}
+
+	// cursor mode lists newest first whatever sort is asked for
+	list, err = s.domain.profile.FilterProfileByCursor(ctx, profile.FilterParams{
+		Sort: &profile.FilterSort{Field: profile.SortByUsername, Ascending: true},
+	}, nil, 10)
+	if err != nil {
+		t.Fatalf("FilterProfileByCursor with sort: %v", err)
+	}
+	if len(list.Data) != 3 || list.Data[0].AccountID != third.AccountID {
+		t.Fatalf("FilterProfileByCursor with sort: expected 3 profiles starting with the newest %s", third.AccountID)
+	}
+}
+
+func TestProfileVersionConflict(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+
+	test.CleanDb(t)
+
+	ctx := context.Background()
+	id := uuid.New()
+
+	created, err := s.domain.profile.CreateProfile(ctx, id, "versioned", time.Time{}, false)
+	if err != nil {
+		t.Fatalf("CreateProfile: %v", err)
+	}
+
+	seen := created.Version
+	updated, err := s.domain.profile.UpdateProfile(ctx, id, profile.UpdateParams{
+		Pseudonym: profile.SetField("first tab"),
+		Version:   &seen,
+	})
+	if err != nil {
+		t.Fatalf("UpdateProfile with current version: %v", err)
+	}
+	if updated.Version == seen {
+		t.Fatalf("UpdateProfile with current version: expected version to change from %d", seen)
+	}
+
+	_, err = s.domain.profile.UpdateProfile(ctx, id, profile.UpdateParams{
+		Pseudonym: profile.SetField("second tab"),
+		Version:   &seen,
+	})
+	if !errors.Is(err, errx.ErrorProfileVersionConflict) {
+		t.Fatalf("UpdateProfile with stale version: expected version conflict, got %v", err)
+	}
+
+	current, err := s.domain.profile.GetProfileByID(ctx, id)
+	if err != nil {
+		t.Fatalf("GetProfileByID: %v", err)
+	}
+	if current.Pseudonym == nil || *current.Pseudonym != "first tab" {
+		t.Fatalf("UpdateProfile with stale version: expected pseudonym to stay %q, got %v", "first tab", current.Pseudonym)
+	}
+	if current.Version != updated.Version {
+		t.Fatalf("UpdateProfile with stale version: expected version %d, got %d", updated.Version, current.Version)
+	}
+
+	_, err = s.domain.profile.UpdateProfile(ctx, id, profile.UpdateParams{
+		Pseudonym: profile.SetField("any version"),
+	})
+	if err != nil {
+		t.Fatalf("UpdateProfile without version: %v", err)
+	}
+}
+
+func TestRetiredUsernameRedirect(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+
+	test.CleanDb(t)
+
+	ctx := context.Background()
+	ownerID := uuid.New()
+
+	_, err = s.domain.profile.CreateProfile(ctx, ownerID, "alice", time.Time{}, false)
+	if err != nil {
+		t.Fatalf("CreateProfile owner: %v", err)
+	}
+
+	_, err = s.domain.profile.UpdateProfileUsername(ctx, ownerID, "alice_new", time.Now().UTC())
+	if err != nil {
+		t.Fatalf("UpdateProfileUsername owner: %v", err)
+	}
+
+	got, err := s.domain.profile.GetProfileByUsername(ctx, "alice")
+	if err != nil {
+		t.Fatalf("GetProfileByUsername retired: %v", err)
+	}
+	if got.AccountID != ownerID || got.Username != "alice_new" {
+		t.Fatalf("GetProfileByUsername retired: expected owner %s as alice_new, got %s as %s", ownerID, got.AccountID, got.Username)
+	}
+
+	got, err = s.domain.profile.GetProfileByUsername(ctx, "alice_new")
+	if err != nil {
+		t.Fatalf("GetProfileByUsername current: %v", err)
+	}
+	if got.AccountID != ownerID {
+		t.Fatalf("GetProfileByUsername current: expected owner %s, got %s", ownerID, got.AccountID)
+	}
+
+	// once someone else takes the released name, it is theirs
+	newcomerID := uuid.New()
+	_, err = s.domain.profile.CreateProfile(ctx, newcomerID, "alice", time.Time{}, false)
+	if err != nil {
+		t.Fatalf("CreateProfile newcomer: %v", err)
+	}
+
+	got, err = s.domain.profile.GetProfileByUsername(ctx, "alice")
+	if err != nil {
+		t.Fatalf("GetProfileByUsername taken: %v", err)
+	}
+	if got.AccountID != newcomerID {
+		t.Fatalf("GetProfileByUsername taken: expected current holder %s, got %s", newcomerID, got.AccountID)
+	}
+
+	_, err = s.domain.profile.GetProfileByUsername(ctx, "nobody")
+	if !errors.Is(err, errx.ErrorProfileNotFound) {
+		t.Fatalf("GetProfileByUsername unknown: expected not found, got %v", err)
+	}
+}
+
+func TestUpdateProfileHidden(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+
+	test.CleanDb(t)
+
+	ctx := context.Background()
+	id := uuid.New()
+
+	_, err = s.domain.profile.CreateProfile(ctx, id, "hideable", time.Time{}, false)
+	if err != nil {
+		t.Fatalf("CreateProfile: %v", err)
+	}
+
+	hidden, err := s.domain.profile.UpdateProfileHidden(ctx, id, true)
+	if err != nil {
+		t.Fatalf("UpdateProfileHidden hide: %v", err)
+	}
+	if !hidden.Hidden {
+		t.Fatalf("UpdateProfileHidden hide: expected profile to be hidden")
+	}
+
+	shown, err := s.domain.profile.UpdateProfileHidden(ctx, id, false)
+	if err != nil {
+		t.Fatalf("UpdateProfileHidden show: %v", err)
+	}
+	if shown.Hidden {
+		t.Fatalf("UpdateProfileHidden show: expected profile to be shown")
+	}
+
+	// a status change of an account without a profile must not fail and be retried
+	missing, err := s.domain.profile.UpdateProfileHidden(ctx, uuid.New(), true)
+	if err != nil {
+		t.Fatalf("UpdateProfileHidden missing: expected no error, got %v", err)
+	}
+	if !missing.IsNil() {
+		t.Fatalf("UpdateProfileHidden missing: expected no profile, got %s", missing.AccountID)
+	}
 }
@@ -5,8 +5,10 @@ import (
 	"log"
 	"testing"
 
+	"github.com/umisto/logium"
 	"github.com/umisto/profiles-svc/internal"
 	domain2 "github.com/umisto/profiles-svc/internal/domain/modules/profile"
+	"github.com/umisto/profiles-svc/internal/events/producer"
 	"github.com/umisto/profiles-svc/internal/repo"
 )
 
@@ -22,6 +24,7 @@ func mustExec(t *testing.T, db *sql.DB, q string, args ...any) {
 
 type Setup struct {
 	domain domain
+	repo   *repo.Repository
 	Cfg    internal.Config
 }
 
@@ -45,14 +48,17 @@ func newSetup(t *testing.T) (Setup, error) {
 		log.Fatal("failed to connect to database", "error", err)
 	}
 
-	database := repo.NewDatabase(pg)
+	database := repo.New(pg)
+	logger := logium.NewLogger("debug", "text")
+	events := producer.New(logger, database)
 
-	profileSvc := domain2.New(database)
+	profileSvc := domain2.New(logger, database, events, nil, domain2.Config{AllowExternalAvatars: true})
 
 	return Setup{
 		domain: domain{
 			profile: profileSvc,
 		},
-		Cfg: cfg,
+		repo: database,
+		Cfg:  cfg,
 	}, nil
 }